@@ -6,6 +6,8 @@ require (
 	github.com/stretchr/testify v1.10.0
 	gitlab.com/matthewhughes/go-cov v0.4.0
 	gitlab.com/matthewhughes/mages v0.2.0
+	golang.org/x/sync v0.10.0
+	golang.org/x/time v0.8.0
 )
 
 require (
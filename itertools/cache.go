@@ -0,0 +1,80 @@
+package itertools
+
+import (
+	"container/list"
+	"iter"
+)
+
+// MapCached is like [Map] but caches the result of mapFunc for each key, as
+// returned by keyFunc, so that mapFunc is called once per distinct key
+// rather than once per value, e.g. to avoid repeating an expensive lookup.
+//
+// If maxEntries is positive, at most that many results are cached, with
+// the least recently used result evicted to make room for a new one.
+// Otherwise, every result is cached. The cache is local to each iteration.
+//
+// Panics if maxEntries is negative.
+func MapCached[V1 any, V2 any, K comparable](
+	mapFunc func(V1) V2,
+	keyFunc func(V1) K,
+	seq iter.Seq[V1],
+	maxEntries int,
+) iter.Seq[V2] {
+	if maxEntries < 0 {
+		panic("maxEntries for MapCached must be non-negative")
+	}
+	return func(yield func(V2) bool) {
+		cache := newLRU[K, V2](maxEntries)
+		for v := range seq {
+			key := keyFunc(v)
+			res, ok := cache.get(key)
+			if !ok {
+				res = mapFunc(v)
+				cache.put(key, res)
+			}
+			if !yield(res) {
+				return
+			}
+		}
+	}
+}
+
+// lru is a map that, if it has a positive maximum size, evicts the least
+// recently used entry once that size is exceeded
+type lru[K comparable, V any] struct {
+	maxEntries int
+	// front to back, most to least recently used
+	order   *list.List
+	entries map[K]*list.Element
+}
+
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+func newLRU[K comparable, V any](maxEntries int) *lru[K, V] {
+	return &lru[K, V]{
+		maxEntries: maxEntries,
+		order:      list.New(),
+		entries:    make(map[K]*list.Element),
+	}
+}
+
+func (c *lru[K, V]) get(key K) (V, bool) { //nolint:ireturn
+	elem, ok := c.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(lruEntry[K, V]).value, true //nolint:errcheck
+}
+
+func (c *lru[K, V]) put(key K, value V) {
+	c.entries[key] = c.order.PushFront(lruEntry[K, V]{key, value})
+	if c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		oldest := c.order.Remove(c.order.Back()).(lruEntry[K, V]) //nolint:errcheck
+		delete(c.entries, oldest.key)
+	}
+}
@@ -0,0 +1,32 @@
+package itertools_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+)
+
+func ExampleMapCached() {
+	// a stand-in for an expensive lookup, e.g. resolving a hostname
+	lookup := func(host string) string {
+		fmt.Println("looking up", host)
+		return strings.ToUpper(host)
+	}
+	requests := slices.Values([]string{"a.example", "b.example", "a.example", "a.example"})
+
+	byHost := func(host string) string { return host }
+
+	for resolved := range itertools.MapCached(lookup, byHost, requests, 100) {
+		fmt.Println(resolved)
+	}
+
+	// output:
+	// looking up a.example
+	// A.EXAMPLE
+	// looking up b.example
+	// B.EXAMPLE
+	// A.EXAMPLE
+	// A.EXAMPLE
+}
@@ -0,0 +1,81 @@
+package itertools_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+)
+
+func identity[V any](v V) V { return v }
+
+func TestMapCached(t *testing.T) {
+	for _, tc := range []struct {
+		vals          []int
+		maxEntries    int
+		expectedCalls []int
+	}{
+		{nil, 0, nil},
+		{[]int{1, 2, 1, 2, 3}, 0, []int{1, 2, 3}},
+		{[]int{1, 2, 1, 2, 3}, 3, []int{1, 2, 3}},
+		{[]int{1, 2, 1, 2, 3}, 1, []int{1, 2, 1, 2, 3}},
+		// 1 is used more recently than 2, so 2 is evicted to make room for 3
+		{[]int{1, 2, 1, 3, 1, 2}, 2, []int{1, 2, 3, 2}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			var calls []int
+			double := func(n int) int {
+				calls = append(calls, n)
+				return n * 2
+			}
+
+			got := slices.Collect(
+				itertools.MapCached(double, identity[int], slices.Values(tc.vals), tc.maxEntries),
+			)
+
+			expected := slices.Collect(
+				itertools.Map(func(n int) int { return n * 2 }, slices.Values(tc.vals)),
+			)
+			require.Equal(t, expected, got)
+			require.Equal(t, tc.expectedCalls, calls)
+		})
+	}
+}
+
+func TestMapCached_keyFunc(t *testing.T) {
+	var calls int
+	seq := itertools.MapCached(
+		func(s string) int {
+			calls++
+			return len(s)
+		},
+		strings.ToLower,
+		slices.Values([]string{"Go", "GO", "go", "iter"}),
+		0,
+	)
+
+	got := slices.Collect(seq)
+
+	require.Equal(t, []int{2, 2, 2, 4}, got)
+	require.Equal(t, 2, calls)
+}
+
+func TestMapCached_earlyStop(t *testing.T) {
+	seq := itertools.MapCached(identity[int], identity[int], itertools.RangeFrom(0, 1), 0)
+
+	got := slices.Collect(itertools.SliceUntil(seq, 2, 1))
+
+	require.Equal(t, []int{0, 1}, got)
+}
+
+func TestMapCached_negativeMaxEntries(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"maxEntries for MapCached must be non-negative",
+		func() { itertools.MapCached(identity[int], identity[int], slices.Values([]int{1}), -1) },
+	)
+}
@@ -0,0 +1,97 @@
+package itertools
+
+import (
+	"context"
+	"iter"
+	"reflect"
+	"slices"
+)
+
+// FromChan returns a [iter.Seq] that yields values received from ch until
+// it is closed.
+func FromChan[V any](ch <-chan V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// FromChanCtx is like [FromChan] but additionally stops when ctx is
+// cancelled, even if no value is ready to be received from ch.
+func FromChanCtx[V any](ctx context.Context, ch <-chan V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for {
+			select {
+			case v, ok := <-ch:
+				if !ok || !yield(v) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// ToChan drives seq in a new goroutine, sending each value on the returned
+// channel. The channel is closed once seq is exhausted, or once ctx is
+// cancelled, in which case iteration of seq is stopped. Callers that stop
+// receiving early should cancel ctx so the goroutine can exit.
+//
+// The channel's buffer size is set by [WithBuffer], other options are
+// ignored.
+func ToChan[V any](ctx context.Context, seq iter.Seq[V], opts ...Option) <-chan V {
+	config := newParConfig(opts)
+
+	ch := make(chan V, config.buffer)
+	go func() {
+		defer close(ch)
+		for v := range seq {
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// MergeChans returns a [iter.Seq] that yields values received from any of
+// chs, in the order they are received, until all of chs are closed or ctx
+// is cancelled.
+func MergeChans[V any](ctx context.Context, chs ...<-chan V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		// the first case is always the context, the rest the open channels
+		cases := make([]reflect.SelectCase, 0, len(chs)+1)
+		cases = append(cases, reflect.SelectCase{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(ctx.Done()),
+		})
+		for _, ch := range chs {
+			cases = append(cases, reflect.SelectCase{
+				Dir:  reflect.SelectRecv,
+				Chan: reflect.ValueOf(ch),
+			})
+		}
+
+		for len(cases) > 1 {
+			chosen, v, ok := reflect.Select(cases)
+			if chosen == 0 {
+				return
+			}
+			if !ok {
+				cases = slices.Delete(cases, chosen, chosen+1)
+				continue
+			}
+			var x V
+			reflect.ValueOf(&x).Elem().Set(v)
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package itertools_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+)
+
+func ExampleFromChan() {
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		for i := range 5 {
+			ch <- i
+		}
+	}()
+
+	evens := itertools.Filter(func(x int) bool { return x%2 == 0 }, itertools.FromChan(ch))
+	for x := range evens {
+		fmt.Println(x)
+	}
+
+	// output:
+	// 0
+	// 2
+	// 4
+}
+
+func ExampleFromChanCtx() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	// nothing is ever sent on this channel
+	ch := make(chan int)
+
+	for x := range itertools.FromChanCtx(ctx, ch) {
+		fmt.Println(x)
+	}
+	fmt.Println(ctx.Err())
+
+	// output:
+	// context deadline exceeded
+}
+
+func ExampleToChan() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch := itertools.ToChan(ctx, itertools.RangeFrom(0, 1))
+	for x := range ch {
+		if x == 3 {
+			break
+		}
+		fmt.Println(x)
+	}
+
+	// output:
+	// 0
+	// 1
+	// 2
+}
+
+func ExampleMergeChans() {
+	produce := func(vals ...string) <-chan string {
+		ch := make(chan string)
+		go func() {
+			defer close(ch)
+			for _, v := range vals {
+				ch <- v
+			}
+		}()
+		return ch
+	}
+
+	merged := itertools.MergeChans(context.Background(), produce("a", "b"), produce("c"))
+	for v := range merged {
+		fmt.Println(v)
+	}
+
+	// unordered output:
+	// a
+	// b
+	// c
+}
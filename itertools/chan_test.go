@@ -0,0 +1,171 @@
+package itertools_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+)
+
+func sendAll[V any](vals []V) <-chan V {
+	ch := make(chan V, len(vals))
+	for _, v := range vals {
+		ch <- v
+	}
+	close(ch)
+	return ch
+}
+
+func TestFromChan(t *testing.T) {
+	for _, tc := range []struct {
+		vals []int
+	}{
+		{nil},
+		{[]int{1}},
+		{[]int{1, 2, 3}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := slices.Collect(itertools.FromChan(sendAll(tc.vals)))
+
+			require.Equal(t, tc.vals, got)
+		})
+	}
+}
+
+func TestFromChan_earlyStop(t *testing.T) {
+	got := slices.Collect(itertools.SliceUntil(itertools.FromChan(sendAll([]int{1, 2, 3})), 1, 1))
+
+	require.Equal(t, []int{1}, got)
+}
+
+func TestFromChanCtx(t *testing.T) {
+	got := slices.Collect(itertools.FromChanCtx(context.Background(), sendAll([]int{1, 2, 3})))
+
+	require.Equal(t, []int{1, 2, 3}, got)
+}
+
+func TestFromChanCtx_earlyStop(t *testing.T) {
+	seq := itertools.FromChanCtx(context.Background(), sendAll([]int{1, 2, 3}))
+
+	got := slices.Collect(itertools.SliceUntil(seq, 1, 1))
+
+	require.Equal(t, []int{1}, got)
+}
+
+func TestFromChanCtx_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// never closed, and never sent to after the first value
+	ch := make(chan int, 1)
+	ch <- 1
+
+	var got []int
+	for v := range itertools.FromChanCtx(ctx, ch) {
+		got = append(got, v)
+		cancel()
+	}
+
+	require.Equal(t, []int{1}, got)
+}
+
+func TestToChan(t *testing.T) {
+	for _, tc := range []struct {
+		vals []int
+		buf  int
+	}{
+		{nil, 0},
+		{[]int{1, 2, 3}, 0},
+		{[]int{1, 2, 3}, 1},
+		{[]int{1, 2, 3}, 10},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			var got []int
+			ch := itertools.ToChan(
+				context.Background(),
+				slices.Values(tc.vals),
+				itertools.WithBuffer(tc.buf),
+			)
+			for v := range ch {
+				got = append(got, v)
+			}
+
+			require.Equal(t, tc.vals, got)
+		})
+	}
+}
+
+func TestToChan_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stopped := make(chan struct{})
+	seq := func(yield func(int) bool) {
+		defer close(stopped)
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	ch := itertools.ToChan(ctx, seq)
+	require.Equal(t, 0, <-ch)
+	cancel()
+	<-stopped
+
+	// drain anything sent before cancellation was observed
+	for range ch {
+	}
+}
+
+func TestMergeChans(t *testing.T) {
+	for _, tc := range []struct {
+		vals [][]int
+	}{
+		{nil},
+		{[][]int{nil}},
+		{[][]int{{1, 2, 3}}},
+		{[][]int{{1, 2}, nil, {3, 4, 5}}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			chs := make([]<-chan int, len(tc.vals))
+			var expected []int
+			for i, vals := range tc.vals {
+				chs[i] = sendAll(vals)
+				expected = append(expected, vals...)
+			}
+
+			got := slices.Collect(itertools.MergeChans(context.Background(), chs...))
+
+			require.ElementsMatch(t, expected, got)
+		})
+	}
+}
+
+func TestMergeChans_earlyStop(t *testing.T) {
+	seq := itertools.MergeChans(context.Background(), sendAll([]int{1, 2}), sendAll([]int{3}))
+
+	got := slices.Collect(itertools.SliceUntil(seq, 1, 1))
+
+	require.Len(t, got, 1)
+}
+
+func TestMergeChans_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// never closed
+	ch := make(chan int)
+
+	got := slices.Collect(itertools.MergeChans(ctx, ch))
+
+	require.Empty(t, got)
+}
+
+func TestMergeChans_nilInterface(t *testing.T) {
+	got := slices.Collect(itertools.MergeChans(context.Background(), sendAll([]error{nil})))
+
+	require.Equal(t, []error{nil}, got)
+}
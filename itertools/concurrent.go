@@ -0,0 +1,241 @@
+package itertools
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"sync"
+
+	"github.com/matthewhughes934/go-itertools/itertools/tuple"
+)
+
+// FanOut splits seq into n sequences such that each value of seq is yielded
+// by exactly one of them: whichever sequence next asks for a value receives
+// it. The returned sequences may be iterated from different goroutines to
+// distribute work. Each should be iterated at most once, later iterations
+// yield nothing. seq is stopped once it is exhausted or once all n sequences
+// have finished iterating.
+//
+// Panics if n is not positive.
+func FanOut[V any](seq iter.Seq[V], n int) []iter.Seq[V] {
+	if n <= 0 {
+		panic("n for FanOut must be a positive integer")
+	}
+
+	var (
+		mu        sync.Mutex
+		next      func() (V, bool)
+		stop      func()
+		done      bool
+		started   = make([]bool, n)
+		remaining = n
+	)
+
+	begin := func(i int) bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if started[i] {
+			return false
+		}
+		started[i] = true
+		return true
+	}
+
+	pull := func() (V, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if done {
+			var zero V
+			return zero, false
+		}
+		if next == nil {
+			next, stop = iter.Pull(seq)
+		}
+		v, ok := next()
+		if !ok {
+			done = true
+			stop()
+		}
+		return v, ok
+	}
+
+	finish := func() {
+		mu.Lock()
+		defer mu.Unlock()
+
+		remaining--
+		// a sequence always pulls before finishing, so stop has been set
+		if remaining == 0 && !done {
+			done = true
+			stop()
+		}
+	}
+
+	seqs := make([]iter.Seq[V], n)
+	for i := range seqs {
+		seqs[i] = func(yield func(V) bool) {
+			if !begin(i) {
+				return
+			}
+			defer finish()
+			for {
+				v, ok := pull()
+				if !ok || !yield(v) {
+					return
+				}
+			}
+		}
+	}
+	return seqs
+}
+
+// ConcurrentSafe returns a [iter.Seq] that may be iterated from any number
+// of goroutines at once, with each value of seq yielded to exactly one of
+// them: whichever next asks for a value receives it. Together, the
+// iterations drain seq once, like workers taking jobs from a queue, and
+// iterating after seq is exhausted yields nothing.
+//
+// Since another iteration could always start, stopping one iteration early
+// doesn't stop seq, which is only stopped once it is exhausted.
+func ConcurrentSafe[V any](seq iter.Seq[V]) iter.Seq[V] {
+	var (
+		mu   sync.Mutex
+		next func() (V, bool)
+		stop func()
+		done bool
+	)
+
+	pull := func() (V, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if done {
+			var zero V
+			return zero, false
+		}
+		if next == nil {
+			next, stop = iter.Pull(seq)
+		}
+		v, ok := next()
+		if !ok {
+			done = true
+			stop()
+		}
+		return v, ok
+	}
+
+	return func(yield func(V) bool) {
+		for {
+			v, ok := pull()
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Buffered returns a [iter.Seq] that yields the values of seq, which is
+// iterated in a background goroutine ahead of the consumer, so that slow
+// producers and consumers can overlap. If iteration stops early, seq is
+// stopped and the background goroutine exits before Buffered returns.
+//
+// How many values seq may run ahead, beyond the one waiting to be received,
+// is set by [WithBuffer], other options are ignored.
+func Buffered[V any](seq iter.Seq[V], opts ...Option) iter.Seq[V] {
+	config := newParConfig(opts)
+
+	return func(yield func(V) bool) {
+		values := make(chan V, config.buffer)
+		done := make(chan struct{})
+		var wg sync.WaitGroup
+		defer wg.Wait()
+		defer close(done)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(values)
+			for v := range seq {
+				select {
+				case values <- v:
+				case <-done:
+					return
+				}
+			}
+		}()
+
+		for v := range values {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// CombineLatest returns a [iter.Seq] that iterates all of seqs concurrently
+// and, whenever any of them produces a value, yields a snapshot of the most
+// recent value of each, in the same order as seqs. Nothing is yielded until
+// every one of seqs has produced at least one value. Iteration ends once all
+// of seqs are exhausted or ctx is cancelled. On cancellation it ends without
+// waiting for any of seqs blocked producing a value, which are stopped in the
+// background once they produce one.
+//
+// Each yielded slice is a new copy and may be retained by the caller.
+func CombineLatest[V any](ctx context.Context, seqs ...iter.Seq[V]) iter.Seq[[]V] {
+	return func(yield func([]V) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		cancelled := false
+		defer func() {
+			cancel()
+			if !cancelled {
+				wg.Wait()
+			}
+		}()
+
+		updates := make(chan tuple.Pair[int, V])
+		for i, seq := range seqs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for v := range seq {
+					select {
+					case updates <- tuple.NewPair(i, v):
+					case <-ctx.Done():
+						return
+					}
+				}
+			}()
+		}
+		go func() {
+			wg.Wait()
+			close(updates)
+		}()
+
+		latest := make([]V, len(seqs))
+		seen := make([]bool, len(seqs))
+		missing := len(seqs)
+		for {
+			select {
+			case update, ok := <-updates:
+				if !ok {
+					return
+				}
+				i, v := update.Unpack()
+				latest[i] = v
+				if !seen[i] {
+					seen[i] = true
+					missing--
+				}
+				if missing == 0 && !yield(slices.Clone(latest)) {
+					return
+				}
+			case <-ctx.Done():
+				cancelled = true
+				return
+			}
+		}
+	}
+}
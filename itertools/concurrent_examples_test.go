@@ -0,0 +1,93 @@
+package itertools_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+)
+
+func ExampleFanOut() {
+	jobs := slices.Values([]string{"a", "b", "c", "d"})
+
+	var wg sync.WaitGroup
+	for _, worker := range itertools.FanOut(jobs, 2) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range worker {
+				// each job is handled by exactly one of the workers
+				fmt.Println("handled", job)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// unordered output:
+	// handled a
+	// handled b
+	// handled c
+	// handled d
+}
+
+func ExampleConcurrentSafe() {
+	jobs := itertools.ConcurrentSafe(itertools.Range(1, 11, 1))
+
+	var (
+		wg    sync.WaitGroup
+		total atomic.Int64
+	)
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := range jobs {
+				total.Add(int64(n))
+			}
+		}()
+	}
+	wg.Wait()
+	fmt.Println(total.Load())
+
+	// output:
+	// 55
+}
+
+func ExampleBuffered() {
+	fetch := func(yield func(int) bool) {
+		for page := range 3 {
+			// e.g. a slow network request
+			time.Sleep(time.Millisecond)
+			if !yield(page) {
+				return
+			}
+		}
+	}
+
+	// the next pages are fetched while the current one is being handled
+	for page := range itertools.Buffered(fetch, itertools.WithBuffer(2)) {
+		fmt.Println(page)
+	}
+
+	// output:
+	// 0
+	// 1
+	// 2
+}
+
+func ExampleCombineLatest() {
+	ctx := context.Background()
+	temperature := slices.Values([]string{"20C"})
+	humidity := slices.Values([]string{"40%"})
+
+	for snapshot := range itertools.CombineLatest(ctx, temperature, humidity) {
+		fmt.Println(snapshot)
+	}
+
+	// output:
+	// [20C 40%]
+}
@@ -0,0 +1,286 @@
+package itertools_test
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+)
+
+func TestFanOut(t *testing.T) {
+	for _, tc := range []struct {
+		vals []int
+		n    int
+	}{
+		{nil, 1},
+		{nil, 3},
+		{[]int{1, 2, 3}, 1},
+		{[]int{1, 2, 3}, 2},
+		{slices.Collect(itertools.Range(0, 100, 1)), 4},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			seqs := itertools.FanOut(slices.Values(tc.vals), tc.n)
+			require.Len(t, seqs, tc.n)
+
+			var (
+				mu  sync.Mutex
+				wg  sync.WaitGroup
+				got []int
+			)
+			for _, seq := range seqs {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for v := range seq {
+						mu.Lock()
+						got = append(got, v)
+						mu.Unlock()
+					}
+				}()
+			}
+			wg.Wait()
+
+			require.ElementsMatch(t, tc.vals, got)
+		})
+	}
+}
+
+func TestFanOut_sequential(t *testing.T) {
+	seqs := itertools.FanOut(slices.Values([]int{1, 2, 3, 4}), 2)
+
+	first := slices.Collect(itertools.SliceUntil(seqs[0], 1, 1))
+	second := slices.Collect(seqs[1])
+	rest := slices.Collect(seqs[0])
+
+	require.Equal(t, []int{1}, first)
+	require.Equal(t, []int{2, 3, 4}, second)
+	require.Empty(t, rest)
+}
+
+func TestFanOut_earlyStop(t *testing.T) {
+	var stopped bool
+	seq := func(yield func(int) bool) {
+		defer func() { stopped = true }()
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	seqs := itertools.FanOut(seq, 2)
+
+	first := slices.Collect(itertools.SliceUntil(seqs[0], 2, 1))
+	require.False(t, stopped)
+	second := slices.Collect(itertools.SliceUntil(seqs[1], 2, 1))
+
+	require.True(t, stopped)
+	require.Equal(t, []int{0, 1}, first)
+	require.Equal(t, []int{2, 3}, second)
+}
+
+func TestFanOut_iteratedTwice(t *testing.T) {
+	var stopped bool
+	seq := func(yield func(int) bool) {
+		defer func() { stopped = true }()
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	seqs := itertools.FanOut(seq, 2)
+
+	first := slices.Collect(itertools.SliceUntil(seqs[0], 1, 1))
+	again := slices.Collect(seqs[0])
+
+	require.False(t, stopped)
+	second := slices.Collect(itertools.SliceUntil(seqs[1], 2, 1))
+
+	require.True(t, stopped)
+	require.Equal(t, []int{0}, first)
+	require.Empty(t, again)
+	require.Equal(t, []int{1, 2}, second)
+}
+
+func TestFanOut_invalidN(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"n for FanOut must be a positive integer",
+		func() { itertools.FanOut(slices.Values([]int{1}), 0) },
+	)
+}
+
+func TestConcurrentSafe(t *testing.T) {
+	for _, tc := range []struct {
+		vals    []int
+		workers int
+	}{
+		{nil, 1},
+		{nil, 3},
+		{[]int{1, 2, 3}, 1},
+		{slices.Collect(itertools.Range(0, 100, 1)), 4},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			seq := itertools.ConcurrentSafe(slices.Values(tc.vals))
+
+			var (
+				mu  sync.Mutex
+				wg  sync.WaitGroup
+				got []int
+			)
+			for range tc.workers {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for v := range seq {
+						mu.Lock()
+						got = append(got, v)
+						mu.Unlock()
+					}
+				}()
+			}
+			wg.Wait()
+
+			require.ElementsMatch(t, tc.vals, got)
+			require.Empty(t, slices.Collect(seq))
+		})
+	}
+}
+
+func TestConcurrentSafe_earlyStop(t *testing.T) {
+	seq := itertools.ConcurrentSafe(slices.Values([]int{1, 2, 3, 4}))
+
+	first := slices.Collect(itertools.SliceUntil(seq, 1, 1))
+	rest := slices.Collect(seq)
+
+	require.Equal(t, []int{1}, first)
+	require.Equal(t, []int{2, 3, 4}, rest)
+}
+
+func TestBuffered(t *testing.T) {
+	for _, tc := range []struct {
+		vals []int
+		n    int
+	}{
+		{nil, 0},
+		{nil, 2},
+		{[]int{1, 2, 3}, 0},
+		{[]int{1, 2, 3}, 1},
+		{[]int{1, 2, 3}, 5},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := slices.Collect(
+				itertools.Buffered(slices.Values(tc.vals), itertools.WithBuffer(tc.n)),
+			)
+
+			require.Equal(t, tc.vals, got)
+		})
+	}
+}
+
+func TestBuffered_earlyStop(t *testing.T) {
+	var stopped bool
+	seq := func(yield func(int) bool) {
+		defer func() { stopped = true }()
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	got := slices.Collect(
+		itertools.SliceUntil(itertools.Buffered(seq, itertools.WithBuffer(2)), 3, 1),
+	)
+
+	require.Equal(t, []int{0, 1, 2}, got)
+	require.True(t, stopped)
+}
+
+func TestCombineLatest(t *testing.T) {
+	for _, tc := range []struct {
+		vals     [][]int
+		expected [][]int
+	}{
+		{nil, nil},
+		{[][]int{nil}, nil},
+		{[][]int{{1, 2}, nil}, nil},
+		{[][]int{{1, 2, 3}}, [][]int{{1}, {2}, {3}}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			seqs := make([]iter.Seq[int], len(tc.vals))
+			for i, vals := range tc.vals {
+				seqs[i] = slices.Values(vals)
+			}
+
+			got := slices.Collect(itertools.CombineLatest(context.Background(), seqs...))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestCombineLatest_multipleSources(t *testing.T) {
+	seqs := []iter.Seq[int]{
+		slices.Values([]int{1, 2}),
+		slices.Values([]int{10, 20, 30}),
+	}
+
+	got := slices.Collect(itertools.CombineLatest(context.Background(), seqs...))
+
+	// the interleaving isn't deterministic, but the first snapshot is taken
+	// once both sources have produced a value and the last once both are done
+	require.GreaterOrEqual(t, len(got), 2)
+	require.LessOrEqual(t, len(got), 4)
+	require.Equal(t, []int{2, 30}, got[len(got)-1])
+	for _, snapshot := range got {
+		require.Contains(t, []int{1, 2}, snapshot[0])
+		require.Contains(t, []int{10, 20, 30}, snapshot[1])
+	}
+}
+
+func TestCombineLatest_earlyStop(t *testing.T) {
+	seq := itertools.CombineLatest(context.Background(), itertools.RangeFrom(0, 1))
+
+	got := slices.Collect(itertools.SliceUntil(seq, 2, 1))
+
+	require.Equal(t, [][]int{{0}, {1}}, got)
+}
+
+func TestCombineLatest_cancelledWhileBlocked(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	seq, unblock, stopped := blockedSeq()
+
+	got := slices.Collect(itertools.CombineLatest(ctx, itertools.Keys(seq)))
+
+	require.Empty(t, got)
+	unblock()
+	<-stopped
+}
+
+func TestCombineLatest_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// produces one value, then blocks until cancelled
+	blocking := func(yield func(int) bool) {
+		if yield(1) {
+			<-ctx.Done()
+		}
+	}
+
+	var got [][]int
+	for snapshot := range itertools.CombineLatest(ctx, blocking) {
+		got = append(got, snapshot)
+		cancel()
+	}
+
+	require.Equal(t, [][]int{{1}}, got)
+}
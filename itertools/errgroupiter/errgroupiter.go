@@ -0,0 +1,32 @@
+// Package errgroupiter provides functions for running work from sequences
+// on an [errgroup.Group].
+package errgroupiter
+
+import (
+	"context"
+	"iter"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// GoEach schedules a call to f for each value of seq on g, via
+// [errgroup.Group.Go], so it blocks while g is at its limit of active
+// goroutines. It returns once every value has been scheduled, use
+// [errgroup.Group.Wait] to wait for the calls to finish.
+func GoEach[V any](g *errgroup.Group, seq iter.Seq[V], f func(V) error) {
+	for v := range seq {
+		g.Go(func() error { return f(v) })
+	}
+}
+
+// GoEachCtx is like [GoEach] but stops scheduling calls once ctx is
+// cancelled, e.g. by using the context from [errgroup.WithContext] to stop
+// after the first error.
+func GoEachCtx[V any](ctx context.Context, g *errgroup.Group, seq iter.Seq[V], f func(V) error) {
+	for v := range seq {
+		if ctx.Err() != nil {
+			return
+		}
+		g.Go(func() error { return f(v) })
+	}
+}
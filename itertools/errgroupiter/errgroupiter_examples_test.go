@@ -0,0 +1,47 @@
+package errgroupiter_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+	"github.com/matthewhughes934/go-itertools/itertools/errgroupiter"
+)
+
+func ExampleGoEach() {
+	var g errgroup.Group
+	g.SetLimit(2)
+	paths := slices.Values([]string{"a.txt", "b.txt", "missing.txt"})
+
+	errgroupiter.GoEach(&g, paths, func(path string) error {
+		if path == "missing.txt" {
+			return fmt.Errorf("no such file: %s", path)
+		}
+		return nil
+	})
+
+	fmt.Println(g.Wait())
+
+	// output:
+	// no such file: missing.txt
+}
+
+func ExampleGoEachCtx() {
+	g, ctx := errgroup.WithContext(context.Background())
+	g.SetLimit(1)
+
+	errgroupiter.GoEachCtx(ctx, g, itertools.RangeFrom(0, 1), func(x int) error {
+		if x == 3 {
+			return fmt.Errorf("failed on %d", x)
+		}
+		return nil
+	})
+
+	fmt.Println(g.Wait())
+
+	// output:
+	// failed on 3
+}
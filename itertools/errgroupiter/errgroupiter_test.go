@@ -0,0 +1,103 @@
+package errgroupiter_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/matthewhughes934/go-itertools/itertools/errgroupiter"
+)
+
+func TestGoEach(t *testing.T) {
+	for _, tc := range []struct {
+		vals  []int
+		limit int
+	}{
+		{nil, -1},
+		{[]int{1, 2, 3}, -1},
+		{[]int{1, 2, 3}, 1},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			var (
+				g   errgroup.Group
+				mu  sync.Mutex
+				got []int
+			)
+			g.SetLimit(tc.limit)
+
+			errgroupiter.GoEach(&g, slices.Values(tc.vals), func(v int) error {
+				mu.Lock()
+				defer mu.Unlock()
+				got = append(got, v)
+				return nil
+			})
+
+			require.NoError(t, g.Wait())
+			require.ElementsMatch(t, tc.vals, got)
+		})
+	}
+}
+
+func TestGoEach_error(t *testing.T) {
+	expectedErr := errors.New("bad value")
+	var g errgroup.Group
+
+	errgroupiter.GoEach(&g, slices.Values([]int{1, 2, 3}), func(v int) error {
+		if v == 2 {
+			return expectedErr
+		}
+		return nil
+	})
+
+	require.ErrorIs(t, g.Wait(), expectedErr)
+}
+
+func TestGoEachCtx(t *testing.T) {
+	g, ctx := errgroup.WithContext(context.Background())
+	var (
+		mu  sync.Mutex
+		got []int
+	)
+
+	errgroupiter.GoEachCtx(ctx, g, slices.Values([]int{1, 2, 3}), func(v int) error {
+		mu.Lock()
+		defer mu.Unlock()
+		got = append(got, v)
+		return nil
+	})
+
+	require.NoError(t, g.Wait())
+	require.ElementsMatch(t, []int{1, 2, 3}, got)
+}
+
+func TestGoEachCtx_stopsOnError(t *testing.T) {
+	expectedErr := errors.New("bad value")
+	g, ctx := errgroup.WithContext(context.Background())
+	// run one call at a time, so the error is seen before scheduling more
+	g.SetLimit(1)
+	var stopped bool
+	seq := func(yield func(int) bool) {
+		defer func() { stopped = true }()
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	errgroupiter.GoEachCtx(ctx, g, seq, func(v int) error {
+		if v == 2 {
+			return expectedErr
+		}
+		return nil
+	})
+
+	require.ErrorIs(t, g.Wait(), expectedErr)
+	require.True(t, stopped)
+}
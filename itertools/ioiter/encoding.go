@@ -0,0 +1,105 @@
+package ioiter
+
+import (
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+)
+
+// ErrFrameTooLarge is yielded by [Frames] for a frame larger than the
+// maximum size.
+var ErrFrameTooLarge = errors.New("frame too large")
+
+// JSONValues returns a fallible [iter.Seq2] that yields each JSON value
+// decoded from dec as a T, e.g. from newline delimited JSON. Values are
+// decoded one at a time, so the whole input is never held in memory.
+//
+// To decode the elements of a JSON array, first read its opening '[' with
+// [json.Decoder.Token]. The sequence then ends with the array, and consumes
+// its closing ']'.
+func JSONValues[T any](dec *json.Decoder) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for {
+			if !dec.More() {
+				// the end of the input or of an enclosing array, or an
+				// unexpected delimiter to report
+				if _, err := dec.Token(); err != nil && !errors.Is(err, io.EOF) {
+					yield(zero, err)
+				}
+				return
+			}
+			var v T
+			if err := dec.Decode(&v); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Gob returns a fallible [iter.Seq2] that yields each value decoded from dec
+// as a T, see [gob.Decoder.Decode], until the end of the input.
+func Gob[T any](dec *gob.Decoder) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for {
+			var v T
+			if err := dec.Decode(&v); err != nil {
+				if !errors.Is(err, io.EOF) {
+					yield(zero, err)
+				}
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Frames returns a fallible [iter.Seq2] that yields each length-prefixed
+// frame read from r, where each frame is its length as a big-endian uint32
+// followed by that many bytes. Each yielded frame is a new slice and may be
+// retained by the caller.
+//
+// To avoid allocating an unbounded amount of memory for a corrupt or
+// malicious input, a frame longer than maxSize yields an error wrapping
+// [ErrFrameTooLarge]. A frame cut short by the end of r yields
+// [io.ErrUnexpectedEOF].
+func Frames(r io.Reader, maxSize uint32) iter.Seq2[[]byte, error] {
+	return func(yield func([]byte, error) bool) {
+		var header [4]byte
+		for {
+			if _, err := io.ReadFull(r, header[:]); err != nil {
+				if !errors.Is(err, io.EOF) {
+					yield(nil, err)
+				}
+				return
+			}
+			size := binary.BigEndian.Uint32(header[:])
+			if size > maxSize {
+				yield(nil, fmt.Errorf("%w: %d bytes, maximum %d", ErrFrameTooLarge, size, maxSize))
+				return
+			}
+			frame := make([]byte, size)
+			if _, err := io.ReadFull(r, frame); err != nil {
+				if errors.Is(err, io.EOF) {
+					err = io.ErrUnexpectedEOF
+				}
+				yield(nil, err)
+				return
+			}
+			if !yield(frame, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package ioiter_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/matthewhughes934/go-itertools/itertools/ioiter"
+)
+
+func ExampleJSONValues() {
+	type event struct {
+		Kind string `json:"kind"`
+	}
+	ndjson := `{"kind": "start"}
+{"kind": "stop"}
+`
+
+	dec := json.NewDecoder(strings.NewReader(ndjson))
+	for e, err := range ioiter.JSONValues[event](dec) {
+		if err != nil {
+			fmt.Println("error:", err)
+			return
+		}
+		fmt.Println(e.Kind)
+	}
+
+	// output:
+	// start
+	// stop
+}
+
+func ExampleJSONValues_array() {
+	dec := json.NewDecoder(strings.NewReader(`[1, 2, 3]`))
+	// read the opening '[' so the elements are decoded one by one
+	if _, err := dec.Token(); err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+
+	sum := 0
+	for n, err := range ioiter.JSONValues[int](dec) {
+		if err != nil {
+			fmt.Println("error:", err)
+			return
+		}
+		sum += n
+	}
+	fmt.Println(sum)
+
+	// output:
+	// 6
+}
+
+func ExampleGob() {
+	type point struct{ X, Y int }
+
+	var buf bytes.Buffer
+	enc := gob.NewEncoder(&buf)
+	for _, p := range []point{{1, 2}, {3, 4}} {
+		if err := enc.Encode(p); err != nil {
+			fmt.Println("error:", err)
+			return
+		}
+	}
+
+	for p, err := range ioiter.Gob[point](gob.NewDecoder(&buf)) {
+		if err != nil {
+			fmt.Println("error:", err)
+			return
+		}
+		fmt.Println(p.X, p.Y)
+	}
+
+	// output:
+	// 1 2
+	// 3 4
+}
+
+func ExampleFrames() {
+	var buf []byte
+	for _, msg := range []string{"hello", "world"} {
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(msg))) //nolint:gosec // short messages
+		buf = append(buf, msg...)
+	}
+
+	for frame, err := range ioiter.Frames(bytes.NewReader(buf), 1024) {
+		if err != nil {
+			fmt.Println("error:", err)
+			return
+		}
+		fmt.Printf("%s\n", frame)
+	}
+
+	// output:
+	// hello
+	// world
+}
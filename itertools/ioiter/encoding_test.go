@@ -0,0 +1,263 @@
+package ioiter_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+	"github.com/matthewhughes934/go-itertools/itertools/ioiter"
+	"github.com/matthewhughes934/go-itertools/itertools/itererr"
+	"github.com/matthewhughes934/go-itertools/itertools/tuple"
+)
+
+type record struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func TestJSONValues(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		expected []record
+	}{
+		{"", nil},
+		{`{"name": "a", "count": 1}`, []record{{"a", 1}}},
+		{
+			"{\"name\": \"a\", \"count\": 1}\n{\"name\": \"b\"}\n",
+			[]record{{"a", 1}, {"b", 0}},
+		},
+		{`{"name": "a"} {"count": 2}`, []record{{"a", 0}, {"", 2}}},
+	} {
+		t.Run(fmt.Sprintf("%q", tc.input), func(t *testing.T) {
+			dec := json.NewDecoder(strings.NewReader(tc.input))
+			var got []record
+
+			for v, err := range ioiter.JSONValues[record](dec) {
+				require.NoError(t, err)
+				got = append(got, v)
+			}
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestJSONValues_array(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		expected []int
+	}{
+		{"[]", nil},
+		{"[1, 2, 3]", []int{1, 2, 3}},
+	} {
+		t.Run(fmt.Sprintf("%q", tc.input), func(t *testing.T) {
+			dec := json.NewDecoder(strings.NewReader(tc.input + " 4"))
+			_, err := dec.Token()
+			require.NoError(t, err)
+
+			got, err := itererr.TryCollect(ioiter.JSONValues[int](dec))
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, got)
+
+			// the closing delimiter has been consumed
+			var next int
+			require.NoError(t, dec.Decode(&next))
+			require.Equal(t, 4, next)
+		})
+	}
+}
+
+func TestJSONValues_errors(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		expected []tuple.Pair[int, error]
+	}{
+		{"1 x", []tuple.Pair[int, error]{tuple.NewPair[int, error](1, nil)}},
+		{`1 "a" 2`, []tuple.Pair[int, error]{tuple.NewPair[int, error](1, nil)}},
+		{"1 ]", []tuple.Pair[int, error]{tuple.NewPair[int, error](1, nil)}},
+	} {
+		t.Run(fmt.Sprintf("%q", tc.input), func(t *testing.T) {
+			dec := json.NewDecoder(strings.NewReader(tc.input))
+
+			got := tuple.CollectPairs(ioiter.JSONValues[int](dec))
+
+			require.Len(t, got, len(tc.expected)+1)
+			require.Equal(t, tc.expected, got[:len(tc.expected)])
+			require.Error(t, got[len(got)-1].Second)
+			require.Zero(t, got[len(got)-1].First)
+		})
+	}
+}
+
+func TestJSONValues_readError(t *testing.T) {
+	got := tuple.CollectPairs(ioiter.JSONValues[int](json.NewDecoder(failAfter("1 "))))
+
+	require.Equal(
+		t,
+		[]tuple.Pair[int, error]{tuple.NewPair[int, error](1, nil), tuple.NewPair(0, errTest)},
+		got,
+	)
+}
+
+func TestJSONValues_earlyStop(t *testing.T) {
+	seq := ioiter.JSONValues[int](json.NewDecoder(strings.NewReader("1 2 3")))
+
+	got := tuple.CollectPairs(itertools.SliceUntil2(seq, 2, 1))
+
+	require.Equal(
+		t,
+		[]tuple.Pair[int, error]{
+			tuple.NewPair[int, error](1, nil),
+			tuple.NewPair[int, error](2, nil),
+		},
+		got,
+	)
+}
+
+func gobEncode[T any](t *testing.T, vals ...T) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	enc := gob.NewEncoder(&buf)
+	for _, v := range vals {
+		require.NoError(t, enc.Encode(v))
+	}
+	return buf.Bytes()
+}
+
+func TestGob(t *testing.T) {
+	for _, tc := range []struct {
+		vals []record
+	}{
+		{nil},
+		{[]record{{"a", 1}}},
+		{[]record{{"a", 1}, {"b", 2}, {"c", 3}}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			dec := gob.NewDecoder(bytes.NewReader(gobEncode(t, tc.vals...)))
+
+			got, err := itererr.TryCollect(ioiter.Gob[record](dec))
+
+			require.NoError(t, err)
+			require.Equal(t, tc.vals, got)
+		})
+	}
+}
+
+func TestGob_errors(t *testing.T) {
+	encoded := gobEncode(t, record{"a", 1}, record{"b", 2})
+
+	for _, tc := range []struct {
+		name  string
+		input []byte
+	}{
+		{"truncated", encoded[:len(encoded)-1]},
+		{"wrong type", gobEncode(t, "a", "b")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dec := gob.NewDecoder(bytes.NewReader(tc.input))
+
+			got := tuple.CollectPairs(ioiter.Gob[record](dec))
+
+			require.NotEmpty(t, got)
+			last := got[len(got)-1]
+			require.Error(t, last.Second)
+			require.Zero(t, last.First)
+		})
+	}
+}
+
+func TestGob_earlyStop(t *testing.T) {
+	dec := gob.NewDecoder(bytes.NewReader(gobEncode(t, 1, 2, 3)))
+
+	got := tuple.CollectPairs(itertools.SliceUntil2(ioiter.Gob[int](dec), 2, 1))
+
+	require.Equal(
+		t,
+		[]tuple.Pair[int, error]{
+			tuple.NewPair[int, error](1, nil),
+			tuple.NewPair[int, error](2, nil),
+		},
+		got,
+	)
+}
+
+// frames encodes each of payloads as a length-prefixed frame
+func frames(payloads ...string) []byte {
+	var buf []byte
+	for _, payload := range payloads {
+		//nolint:gosec // test payloads are small
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(payload)))
+		buf = append(buf, payload...)
+	}
+	return buf
+}
+
+func TestFrames(t *testing.T) {
+	for _, tc := range []struct {
+		payloads []string
+	}{
+		{nil},
+		{[]string{""}},
+		{[]string{"a"}},
+		{[]string{"abc", "", "de"}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			r := iotest.OneByteReader(bytes.NewReader(frames(tc.payloads...)))
+			var got []string
+
+			for frame, err := range ioiter.Frames(r, 16) {
+				require.NoError(t, err)
+				got = append(got, string(frame))
+			}
+
+			require.Equal(t, tc.payloads, got)
+		})
+	}
+}
+
+func TestFrames_errors(t *testing.T) {
+	encoded := frames("abc")
+
+	for _, tc := range []struct {
+		name     string
+		input    io.Reader
+		expected error
+	}{
+		{"truncated header", bytes.NewReader(encoded[:2]), io.ErrUnexpectedEOF},
+		{"missing payload", bytes.NewReader(encoded[:4]), io.ErrUnexpectedEOF},
+		{"truncated payload", bytes.NewReader(encoded[:5]), io.ErrUnexpectedEOF},
+		{"too large", bytes.NewReader(frames("abcd")), ioiter.ErrFrameTooLarge},
+		{"read error", failAfter(string(encoded[:5])), errTest},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tuple.CollectPairs(ioiter.Frames(tc.input, 3))
+
+			require.Len(t, got, 1)
+			require.Nil(t, got[0].First)
+			require.ErrorIs(t, got[0].Second, tc.expected)
+		})
+	}
+}
+
+func TestFrames_earlyStop(t *testing.T) {
+	var got []string
+
+	for frame := range ioiter.Frames(bytes.NewReader(frames("a", "b", "c")), 16) {
+		got = append(got, string(frame))
+		if len(got) == 2 {
+			break
+		}
+	}
+
+	require.Equal(t, []string{"a", "b"}, got)
+}
@@ -0,0 +1,56 @@
+package ioiter
+
+import (
+	"io/fs"
+	"iter"
+)
+
+// WalkEntry is a file or directory yielded by [WalkDir].
+type WalkEntry struct {
+	fs.DirEntry
+	// Path is the path of the entry, with root as a prefix, see
+	// [io/fs.WalkDirFunc].
+	Path string
+
+	skip *bool
+}
+
+// SkipDir stops [WalkDir] from descending into e, if e is a directory, or
+// skips the remaining entries in its parent directory otherwise, like
+// returning [io/fs.SkipDir] from an [io/fs.WalkDirFunc]. It only has an
+// effect if called before the next entry is requested.
+func (e WalkEntry) SkipDir() {
+	if e.skip != nil {
+		*e.skip = true
+	}
+}
+
+// WalkDir returns a fallible [iter.Seq2] that yields each file or directory
+// in the tree rooted at root, in lexical order, see [io/fs.WalkDir].
+//
+// An error reading a directory is yielded (with the zero value) and the walk
+// continues without the contents of that directory. An error for root
+// itself is yielded as the only pair.
+func WalkDir(fsys fs.FS, root string) iter.Seq2[WalkEntry, error] {
+	return func(yield func(WalkEntry, error) bool) {
+		// the walk function never returns an error other than SkipDir or
+		// SkipAll, so neither does WalkDir
+		fs.WalkDir(fsys, root, func(path string, d fs.DirEntry, err error) error { //nolint:errcheck
+			if err != nil {
+				if !yield(WalkEntry{}, err) {
+					return fs.SkipAll
+				}
+				return nil
+			}
+
+			var skip bool
+			if !yield(WalkEntry{DirEntry: d, Path: path, skip: &skip}, nil) {
+				return fs.SkipAll
+			}
+			if skip {
+				return fs.SkipDir
+			}
+			return nil
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package ioiter_test
+
+import (
+	"fmt"
+	"path"
+	"testing/fstest"
+
+	"github.com/matthewhughes934/go-itertools/itertools/ioiter"
+)
+
+func ExampleWalkDir() {
+	fsys := fstest.MapFS{
+		"README.md":          {},
+		"src/main.go":        {},
+		"src/util.go":        {},
+		"vendor/lib/lib.go":  {},
+		"vendor/lib/doc.txt": {},
+	}
+
+	for entry, err := range ioiter.WalkDir(fsys, ".") {
+		if err != nil {
+			fmt.Println("error:", err)
+			return
+		}
+		if entry.IsDir() && entry.Name() == "vendor" {
+			entry.SkipDir()
+			continue
+		}
+		if path.Ext(entry.Path) == ".go" {
+			fmt.Println(entry.Path)
+		}
+	}
+
+	// output:
+	// src/main.go
+	// src/util.go
+}
@@ -0,0 +1,149 @@
+package ioiter_test
+
+import (
+	"fmt"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+	"github.com/matthewhughes934/go-itertools/itertools/ioiter"
+	"github.com/matthewhughes934/go-itertools/itertools/tuple"
+)
+
+var testFS = fstest.MapFS{
+	"a.txt":         {},
+	"dir/b.txt":     {},
+	"dir/c.txt":     {},
+	"dir/sub/d.txt": {},
+	"other/e.txt":   {},
+}
+
+// failingFS fails to read the directory failDir
+type failingFS struct {
+	fstest.MapFS
+	failDir string
+}
+
+func (f failingFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if name == f.failDir {
+		return nil, errTest
+	}
+	return f.MapFS.ReadDir(name)
+}
+
+func TestWalkDir(t *testing.T) {
+	for _, tc := range []struct {
+		root     string
+		expected []string
+	}{
+		{"a.txt", []string{"a.txt"}},
+		{"dir/sub", []string{"dir/sub", "dir/sub/d.txt"}},
+		{
+			".",
+			[]string{
+				".",
+				"a.txt",
+				"dir",
+				"dir/b.txt",
+				"dir/c.txt",
+				"dir/sub",
+				"dir/sub/d.txt",
+				"other",
+				"other/e.txt",
+			},
+		},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			var got []string
+
+			for entry, err := range ioiter.WalkDir(testFS, tc.root) {
+				require.NoError(t, err)
+				got = append(got, entry.Path)
+			}
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestWalkDir_skipDir(t *testing.T) {
+	for _, tc := range []struct {
+		skip     string
+		expected []string
+	}{
+		{"dir", []string{".", "a.txt", "dir", "other", "other/e.txt"}},
+		// skipping a file skips the rest of its directory
+		{"dir/b.txt", []string{".", "a.txt", "dir", "dir/b.txt", "other", "other/e.txt"}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			var got []string
+
+			for entry, err := range ioiter.WalkDir(testFS, ".") {
+				require.NoError(t, err)
+				got = append(got, entry.Path)
+				if entry.Path == tc.skip {
+					entry.SkipDir()
+				}
+			}
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestWalkDir_errors(t *testing.T) {
+	var (
+		paths []string
+		errs  []error
+	)
+
+	for entry, err := range ioiter.WalkDir(failingFS{testFS, "dir"}, ".") {
+		if err != nil {
+			require.Zero(t, entry)
+			errs = append(errs, err)
+			continue
+		}
+		paths = append(paths, entry.Path)
+	}
+
+	require.Equal(t, []string{".", "a.txt", "dir", "other", "other/e.txt"}, paths)
+	require.Len(t, errs, 1)
+	require.ErrorIs(t, errs[0], errTest)
+}
+
+func TestWalkDir_missingRoot(t *testing.T) {
+	var errs []error
+
+	for _, err := range ioiter.WalkDir(testFS, "missing") {
+		errs = append(errs, err)
+	}
+
+	require.Len(t, errs, 1)
+	require.ErrorIs(t, errs[0], fs.ErrNotExist)
+}
+
+func TestWalkDir_earlyStop(t *testing.T) {
+	for _, tc := range []struct {
+		fsys     fs.FS
+		expected int
+	}{
+		{testFS, 2},
+		// stopping on the error reading the root
+		{failingFS{testFS, "."}, 2},
+	} {
+		t.Run(fmt.Sprintf("%T", tc.fsys), func(t *testing.T) {
+			seq := ioiter.WalkDir(tc.fsys, ".")
+
+			got := tuple.CollectPairs(itertools.SliceUntil2(seq, tc.expected, 1))
+
+			require.Len(t, got, tc.expected)
+		})
+	}
+}
+
+func TestWalkEntry_SkipDirZero(t *testing.T) {
+	require.NotPanics(t, func() { ioiter.WalkEntry{}.SkipDir() })
+}
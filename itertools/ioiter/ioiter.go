@@ -0,0 +1,157 @@
+// Package ioiter provides functions for producing sequences from readers,
+// e.g. to stream the lines of a file rather than reading it all into memory.
+//
+// The sequences here are fallible, i.e. of type iter.Seq2[V, error], see
+// the itererr package for working with them. A read error is yielded (with
+// the zero value) as the final pair of the sequence, reaching the end of the
+// reader is not an error.
+package ioiter
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"iter"
+	"strings"
+)
+
+// Lines returns a fallible [iter.Seq2] that yields each line read from r,
+// without its line ending of either "\n" or "\r\n". The last line need not
+// have a line ending, and there is no limit on the length of a line.
+func Lines(r io.Reader) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		br := bufio.NewReader(r)
+		for {
+			line, err := br.ReadString('\n')
+			if err != nil && !errors.Is(err, io.EOF) {
+				yield("", err)
+				return
+			}
+			if len(line) > 0 {
+				line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+				if !yield(line, nil) {
+					return
+				}
+			}
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Runes returns a fallible [iter.Seq2] that yields each rune decoded from
+// the UTF-8 read from r. Like [bufio.Reader.ReadRune], invalid UTF-8 is not
+// an error but yields [unicode/utf8.RuneError] for each invalid byte.
+func Runes(r io.Reader) iter.Seq2[rune, error] {
+	return func(yield func(rune, error) bool) {
+		br := bufio.NewReader(r)
+		for {
+			c, _, err := br.ReadRune()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					yield(0, err)
+				}
+				return
+			}
+			if !yield(c, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Chunks returns a fallible [iter.Seq2] that yields the content of r in
+// chunks of size bytes, except possibly the last which holds whatever
+// remains.
+//
+// To keep memory use bounded, the same buffer is reused for each chunk, so a
+// chunk is only valid until the next one is requested and must be copied,
+// e.g. with [bytes.Clone], to be retained.
+//
+// Chunks panics if size is not positive.
+func Chunks(r io.Reader, size int) iter.Seq2[[]byte, error] {
+	if size <= 0 {
+		panic("size for Chunks must be a positive integer")
+	}
+	return func(yield func([]byte, error) bool) {
+		buf := make([]byte, size)
+		for {
+			n, err := io.ReadFull(r, buf)
+			switch {
+			case errors.Is(err, io.EOF):
+				return
+			case errors.Is(err, io.ErrUnexpectedEOF):
+				yield(buf[:n], nil)
+				return
+			case err != nil:
+				yield(nil, err)
+				return
+			}
+			if !yield(buf, nil) {
+				return
+			}
+		}
+	}
+}
+
+// FromScanner returns a fallible [iter.Seq2] that yields the text of each
+// token from s, see [bufio.Scanner.Text]. If scanning stops with an error,
+// see [bufio.Scanner.Err], it is yielded as the final pair.
+func FromScanner(s *bufio.Scanner) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		for s.Scan() {
+			if !yield(s.Text(), nil) {
+				return
+			}
+		}
+		if err := s.Err(); err != nil {
+			yield("", err)
+		}
+	}
+}
+
+// FromScannerBytes is like [FromScanner] but yields the bytes of each token,
+// see [bufio.Scanner.Bytes]. As such, a token is only valid until the next
+// one is requested and must be copied to be retained.
+func FromScannerBytes(s *bufio.Scanner) iter.Seq2[[]byte, error] {
+	return func(yield func([]byte, error) bool) {
+		for s.Scan() {
+			if !yield(s.Bytes(), nil) {
+				return
+			}
+		}
+		if err := s.Err(); err != nil {
+			yield(nil, err)
+		}
+	}
+}
+
+// WriteTo writes each value of seq to w, returning the total number of bytes
+// written. It stops at the first error, returning it along with the bytes
+// written so far.
+func WriteTo(w io.Writer, seq iter.Seq[[]byte]) (int64, error) {
+	var total int64
+	for b := range seq {
+		n, err := w.Write(b)
+		total += int64(n)
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// WriteStringsTo is like [WriteTo] but for a sequence of strings, see
+// [io.WriteString].
+func WriteStringsTo(w io.Writer, seq iter.Seq[string]) (int64, error) {
+	var total int64
+	for s := range seq {
+		n, err := io.WriteString(w, s)
+		total += int64(n)
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,129 @@
+package ioiter_test
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+	"github.com/matthewhughes934/go-itertools/itertools/ioiter"
+	"github.com/matthewhughes934/go-itertools/itertools/itererr"
+)
+
+func ExampleLines() {
+	r := strings.NewReader("first line\r\nsecond line\nlast line")
+
+	for line, err := range ioiter.Lines(r) {
+		if err != nil {
+			fmt.Println("error:", err)
+			return
+		}
+		fmt.Println(line)
+	}
+
+	// output:
+	// first line
+	// second line
+	// last line
+}
+
+func ExampleRunes() {
+	for c, err := range ioiter.Runes(strings.NewReader("héllo")) {
+		if err != nil {
+			fmt.Println("error:", err)
+			return
+		}
+		fmt.Printf("%c ", c)
+	}
+	fmt.Println()
+
+	// output:
+	// h é l l o
+}
+
+func ExampleChunks() {
+	// e.g. hashing a large file without reading it all into memory
+	h := sha256.New()
+	for chunk, err := range ioiter.Chunks(strings.NewReader("hello, world"), 4) {
+		if err != nil {
+			fmt.Println("error:", err)
+			return
+		}
+		h.Write(chunk)
+	}
+	fmt.Printf("%x\n", h.Sum(nil))
+
+	// output:
+	// 09ca7e4eaa6e8ae9c7d261167129184883644d07dfba7cbfbc4c8a2e08360d5b
+}
+
+func ExampleFromScanner() {
+	s := bufio.NewScanner(strings.NewReader("the quick  brown\nfox"))
+	s.Split(bufio.ScanWords)
+
+	for word, err := range ioiter.FromScanner(s) {
+		if err != nil {
+			fmt.Println("error:", err)
+			return
+		}
+		fmt.Println(word)
+	}
+
+	// output:
+	// the
+	// quick
+	// brown
+	// fox
+}
+
+func ExampleFromScannerBytes() {
+	s := bufio.NewScanner(strings.NewReader("a,b\nc,d"))
+
+	for line, err := range ioiter.FromScannerBytes(s) {
+		if err != nil {
+			fmt.Println("error:", err)
+			return
+		}
+		fmt.Println(bytes.Count(line, []byte(",")) + 1)
+	}
+
+	// output:
+	// 2
+	// 2
+}
+
+func ExampleWriteTo() {
+	lines := slices.Values([][]byte{[]byte("hello\n"), []byte("world\n")})
+
+	n, err := ioiter.WriteTo(os.Stdout, lines)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println(n, "bytes written")
+
+	// output:
+	// hello
+	// world
+	// 12 bytes written
+}
+
+func ExampleWriteStringsTo() {
+	// e.g. streaming a transformed file without collecting it in memory
+	upper := itertools.Map(
+		func(line string) string { return strings.ToUpper(line) + "\n" },
+		itererr.Must(ioiter.Lines(strings.NewReader("a\nb"))),
+	)
+
+	if _, err := ioiter.WriteStringsTo(os.Stdout, upper); err != nil {
+		fmt.Println("error:", err)
+	}
+
+	// output:
+	// A
+	// B
+}
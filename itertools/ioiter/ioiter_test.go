@@ -0,0 +1,412 @@
+package ioiter_test
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+	"github.com/matthewhughes934/go-itertools/itertools/ioiter"
+	"github.com/matthewhughes934/go-itertools/itertools/tuple"
+)
+
+var errTest = errors.New("test error")
+
+// failAfter returns a reader that reads s then fails with errTest
+func failAfter(s string) io.Reader {
+	return io.MultiReader(strings.NewReader(s), iotest.ErrReader(errTest))
+}
+
+func TestLines(t *testing.T) {
+	longLine := strings.Repeat("x", 100_000)
+
+	for _, tc := range []struct {
+		input    string
+		expected []tuple.Pair[string, error]
+	}{
+		{"", nil},
+		{"a", []tuple.Pair[string, error]{tuple.NewPair[string, error]("a", nil)}},
+		{"a\n", []tuple.Pair[string, error]{tuple.NewPair[string, error]("a", nil)}},
+		{
+			"a\nb\r\n\nc",
+			[]tuple.Pair[string, error]{
+				tuple.NewPair[string, error]("a", nil),
+				tuple.NewPair[string, error]("b", nil),
+				tuple.NewPair[string, error]("", nil),
+				tuple.NewPair[string, error]("c", nil),
+			},
+		},
+		{
+			longLine + "\n" + longLine,
+			[]tuple.Pair[string, error]{
+				tuple.NewPair[string, error](longLine, nil),
+				tuple.NewPair[string, error](longLine, nil),
+			},
+		},
+	} {
+		t.Run(fmt.Sprintf("%.20q", tc.input), func(t *testing.T) {
+			r := iotest.OneByteReader(strings.NewReader(tc.input))
+
+			got := tuple.CollectPairs(ioiter.Lines(r))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestLines_readError(t *testing.T) {
+	got := tuple.CollectPairs(ioiter.Lines(failAfter("a\nb")))
+
+	require.Equal(
+		t,
+		[]tuple.Pair[string, error]{
+			tuple.NewPair[string, error]("a", nil),
+			tuple.NewPair("", errTest),
+		},
+		got,
+	)
+}
+
+func TestLines_earlyStop(t *testing.T) {
+	seq := ioiter.Lines(strings.NewReader("a\nb\nc"))
+
+	got := tuple.CollectPairs(itertools.SliceUntil2(seq, 2, 1))
+
+	require.Equal(
+		t,
+		[]tuple.Pair[string, error]{
+			tuple.NewPair[string, error]("a", nil),
+			tuple.NewPair[string, error]("b", nil),
+		},
+		got,
+	)
+}
+
+func TestRunes(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		expected []tuple.Pair[rune, error]
+	}{
+		{"", nil},
+		{
+			"aé世",
+			[]tuple.Pair[rune, error]{
+				tuple.NewPair[rune, error]('a', nil),
+				tuple.NewPair[rune, error]('é', nil),
+				tuple.NewPair[rune, error]('世', nil),
+			},
+		},
+		{
+			"a\xffb",
+			[]tuple.Pair[rune, error]{
+				tuple.NewPair[rune, error]('a', nil),
+				tuple.NewPair[rune, error](utf8.RuneError, nil),
+				tuple.NewPair[rune, error]('b', nil),
+			},
+		},
+	} {
+		t.Run(fmt.Sprintf("%q", tc.input), func(t *testing.T) {
+			r := iotest.OneByteReader(strings.NewReader(tc.input))
+
+			got := tuple.CollectPairs(ioiter.Runes(r))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestRunes_readError(t *testing.T) {
+	got := tuple.CollectPairs(ioiter.Runes(failAfter("a")))
+
+	require.Equal(
+		t,
+		[]tuple.Pair[rune, error]{
+			tuple.NewPair[rune, error]('a', nil),
+			tuple.NewPair[rune](0, errTest),
+		},
+		got,
+	)
+}
+
+func TestRunes_earlyStop(t *testing.T) {
+	seq := ioiter.Runes(strings.NewReader("abc"))
+
+	got := tuple.CollectPairs(itertools.SliceUntil2(seq, 2, 1))
+
+	require.Equal(
+		t,
+		[]tuple.Pair[rune, error]{
+			tuple.NewPair[rune, error]('a', nil),
+			tuple.NewPair[rune, error]('b', nil),
+		},
+		got,
+	)
+}
+
+func TestChunks(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		size     int
+		expected []string
+	}{
+		{"", 1, nil},
+		{"abc", 1, []string{"a", "b", "c"}},
+		{"abcd", 2, []string{"ab", "cd"}},
+		{"abcde", 2, []string{"ab", "cd", "e"}},
+		{"abc", 10, []string{"abc"}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			r := iotest.OneByteReader(strings.NewReader(tc.input))
+			var got []string
+
+			for chunk, err := range ioiter.Chunks(r, tc.size) {
+				require.NoError(t, err)
+				got = append(got, string(chunk))
+			}
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestChunks_readError(t *testing.T) {
+	var got []tuple.Pair[string, error]
+
+	for chunk, err := range ioiter.Chunks(failAfter("abc"), 2) {
+		got = append(got, tuple.NewPair(string(chunk), err))
+	}
+
+	require.Equal(
+		t,
+		[]tuple.Pair[string, error]{
+			tuple.NewPair[string, error]("ab", nil),
+			tuple.NewPair("", errTest),
+		},
+		got,
+	)
+}
+
+func TestChunks_earlyStop(t *testing.T) {
+	var got []string
+
+	for chunk := range ioiter.Chunks(strings.NewReader("abcdef"), 2) {
+		got = append(got, string(chunk))
+		if len(got) == 2 {
+			break
+		}
+	}
+
+	require.Equal(t, []string{"ab", "cd"}, got)
+}
+
+func TestChunks_nonPositiveSize(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"size for Chunks must be a positive integer",
+		func() { ioiter.Chunks(strings.NewReader(""), 0) },
+	)
+}
+
+func TestFromScanner(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		expected []tuple.Pair[string, error]
+	}{
+		{"", nil},
+		{
+			"a b\nc",
+			[]tuple.Pair[string, error]{
+				tuple.NewPair[string, error]("a", nil),
+				tuple.NewPair[string, error]("b", nil),
+				tuple.NewPair[string, error]("c", nil),
+			},
+		},
+	} {
+		t.Run(fmt.Sprintf("%q", tc.input), func(t *testing.T) {
+			s := bufio.NewScanner(strings.NewReader(tc.input))
+			s.Split(bufio.ScanWords)
+
+			got := tuple.CollectPairs(ioiter.FromScanner(s))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestFromScanner_scanError(t *testing.T) {
+	got := tuple.CollectPairs(ioiter.FromScanner(bufio.NewScanner(failAfter("a\nb"))))
+
+	require.Equal(
+		t,
+		[]tuple.Pair[string, error]{
+			tuple.NewPair[string, error]("a", nil),
+			tuple.NewPair[string, error]("b", nil),
+			tuple.NewPair("", errTest),
+		},
+		got,
+	)
+}
+
+func TestFromScanner_earlyStop(t *testing.T) {
+	seq := ioiter.FromScanner(bufio.NewScanner(strings.NewReader("a\nb\nc")))
+
+	got := tuple.CollectPairs(itertools.SliceUntil2(seq, 2, 1))
+
+	require.Equal(
+		t,
+		[]tuple.Pair[string, error]{
+			tuple.NewPair[string, error]("a", nil),
+			tuple.NewPair[string, error]("b", nil),
+		},
+		got,
+	)
+}
+
+func TestFromScannerBytes(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		expected []string
+	}{
+		{"", nil},
+		{"a\nb\nc", []string{"a", "b", "c"}},
+	} {
+		t.Run(fmt.Sprintf("%q", tc.input), func(t *testing.T) {
+			var got []string
+
+			s := bufio.NewScanner(strings.NewReader(tc.input))
+
+			for token, err := range ioiter.FromScannerBytes(s) {
+				require.NoError(t, err)
+				got = append(got, string(token))
+			}
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestFromScannerBytes_scanError(t *testing.T) {
+	var got []tuple.Pair[string, error]
+
+	for token, err := range ioiter.FromScannerBytes(bufio.NewScanner(failAfter("a\nb"))) {
+		got = append(got, tuple.NewPair(string(token), err))
+	}
+
+	require.Equal(
+		t,
+		[]tuple.Pair[string, error]{
+			tuple.NewPair[string, error]("a", nil),
+			tuple.NewPair[string, error]("b", nil),
+			tuple.NewPair("", errTest),
+		},
+		got,
+	)
+}
+
+func TestFromScannerBytes_earlyStop(t *testing.T) {
+	var got []string
+
+	for token := range ioiter.FromScannerBytes(bufio.NewScanner(strings.NewReader("a\nb\nc"))) {
+		got = append(got, string(token))
+		if len(got) == 2 {
+			break
+		}
+	}
+
+	require.Equal(t, []string{"a", "b"}, got)
+}
+
+// failingWriter accepts up to limit bytes, then fails with errTest
+type failingWriter struct {
+	buf   bytes.Buffer
+	limit int
+}
+
+func (w *failingWriter) Write(b []byte) (int, error) {
+	allowed := min(len(b), w.limit-w.buf.Len())
+	n, err := w.buf.Write(b[:allowed])
+	if err == nil && allowed < len(b) {
+		err = errTest
+	}
+	return n, err
+}
+
+func TestWriteTo(t *testing.T) {
+	for _, tc := range []struct {
+		vals []string
+	}{
+		{nil},
+		{[]string{""}},
+		{[]string{"ab", "", "cde"}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			var buf bytes.Buffer
+			seq := itertools.Map(func(s string) []byte { return []byte(s) }, slices.Values(tc.vals))
+
+			n, err := ioiter.WriteTo(&buf, seq)
+
+			require.NoError(t, err)
+			require.Equal(t, strings.Join(tc.vals, ""), buf.String())
+			require.Equal(t, int64(buf.Len()), n)
+		})
+	}
+}
+
+func TestWriteTo_writeError(t *testing.T) {
+	w := &failingWriter{limit: 3}
+	var consumed int
+	seq := func(yield func([]byte) bool) {
+		for _, s := range []string{"ab", "cd", "ef"} {
+			consumed++
+			if !yield([]byte(s)) {
+				return
+			}
+		}
+	}
+
+	n, err := ioiter.WriteTo(w, seq)
+
+	require.ErrorIs(t, err, errTest)
+	require.Equal(t, int64(3), n)
+	require.Equal(t, "abc", w.buf.String())
+	require.Equal(t, 2, consumed)
+}
+
+func TestWriteStringsTo(t *testing.T) {
+	for _, tc := range []struct {
+		vals []string
+	}{
+		{nil},
+		{[]string{""}},
+		{[]string{"ab", "", "cde"}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			var buf strings.Builder
+
+			n, err := ioiter.WriteStringsTo(&buf, slices.Values(tc.vals))
+
+			require.NoError(t, err)
+			require.Equal(t, strings.Join(tc.vals, ""), buf.String())
+			require.Equal(t, int64(buf.Len()), n)
+		})
+	}
+}
+
+func TestWriteStringsTo_writeError(t *testing.T) {
+	w := &failingWriter{limit: 3}
+
+	n, err := ioiter.WriteStringsTo(w, slices.Values([]string{"ab", "cd", "ef"}))
+
+	require.ErrorIs(t, err, errTest)
+	require.Equal(t, int64(3), n)
+	require.Equal(t, "abc", w.buf.String())
+}
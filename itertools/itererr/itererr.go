@@ -0,0 +1,344 @@
+// Package itererr provides functions for working with fallible sequences,
+// i.e. sequences of type iter.Seq2[V, error] that yield either a value with
+// a nil error, or an error (with the zero value) when producing a value
+// failed, e.g. when reading a line from a file or a row from a database.
+//
+// Unless documented otherwise, the functions here pass errors through
+// untouched, leaving it to the consumer to decide whether to stop on one.
+package itererr
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"runtime/debug"
+
+	"github.com/matthewhughes934/go-itertools/itertools/tuple"
+)
+
+// FromSeq returns a fallible [iter.Seq2] that yields each value in seq with
+// a nil error.
+func FromSeq[V any](seq iter.Seq[V]) iter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		for v := range seq {
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Map returns a fallible [iter.Seq2] that yields the result of calling
+// mapFunc on each value in seq. Errors are yielded without calling mapFunc.
+func Map[V1 any, V2 any](mapFunc func(V1) V2, seq iter.Seq2[V1, error]) iter.Seq2[V2, error] {
+	return func(yield func(V2, error) bool) {
+		for v, err := range seq {
+			if err != nil {
+				var zero V2
+				if !yield(zero, err) {
+					return
+				}
+				continue
+			}
+			if !yield(mapFunc(v), nil) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns a fallible [iter.Seq2] that yields those values of seq for
+// which filterFunc is true, along with every error.
+func Filter[V any](filterFunc func(V) bool, seq iter.Seq2[V, error]) iter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		for v, err := range seq {
+			if err == nil && !filterFunc(v) {
+				continue
+			}
+			if !yield(v, err) {
+				return
+			}
+		}
+	}
+}
+
+// Take returns a fallible [iter.Seq2] that yields the first n values of seq.
+// Only values count towards n, errors before the nth value are yielded too.
+//
+// Panics if n is negative.
+func Take[V any](seq iter.Seq2[V, error], n int) iter.Seq2[V, error] {
+	if n < 0 {
+		panic("n for Take must be non-negative")
+	}
+
+	return func(yield func(V, error) bool) {
+		if n == 0 {
+			return
+		}
+
+		count := 0
+		for v, err := range seq {
+			if !yield(v, err) {
+				return
+			}
+			if err == nil {
+				count++
+				if count == n {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Chain returns a fallible [iter.Seq2] that yields the values and errors
+// of each of seqs in turn.
+func Chain[V any](seqs ...iter.Seq2[V, error]) iter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		for _, seq := range seqs {
+			for v, err := range seq {
+				if !yield(v, err) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// TryMap returns a fallible [iter.Seq2] that yields the value and error
+// returned by calling mapFunc on each value in seq.
+func TryMap[V1 any, V2 any](mapFunc func(V1) (V2, error), seq iter.Seq[V1]) iter.Seq2[V2, error] {
+	return func(yield func(V2, error) bool) {
+		for v := range seq {
+			if !yield(mapFunc(v)) {
+				return
+			}
+		}
+	}
+}
+
+// TryFilter returns a fallible [iter.Seq2] that yields those values of seq
+// for which filterFunc returns true, along with any error filterFunc returns.
+func TryFilter[V any](filterFunc func(V) (bool, error), seq iter.Seq[V]) iter.Seq2[V, error] {
+	return TryFilter2(filterFunc, FromSeq(seq))
+}
+
+// TryFilter2 is like [TryFilter] but for a fallible seq, whose errors are
+// yielded without calling filterFunc.
+func TryFilter2[V any](
+	filterFunc func(V) (bool, error),
+	seq iter.Seq2[V, error],
+) iter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		for v, err := range seq {
+			keep := false
+			if err == nil {
+				keep, err = filterFunc(v)
+			}
+
+			var ok bool
+			switch {
+			case err != nil:
+				var zero V
+				ok = yield(zero, err)
+			case keep:
+				ok = yield(v, nil)
+			default:
+				continue
+			}
+			if !ok {
+				return
+			}
+		}
+	}
+}
+
+// TryCollect collects the values of seq into a new slice until the first
+// error, returning the values collected so far along with that error.
+func TryCollect[V any](seq iter.Seq2[V, error]) ([]V, error) {
+	var vals []V
+	for v, err := range seq {
+		if err != nil {
+			return vals, err
+		}
+		vals = append(vals, v)
+	}
+	return vals, nil
+}
+
+// TryCollectAll is like [TryCollect] but collects every value of seq,
+// returning all errors joined by [errors.Join].
+func TryCollectAll[V any](seq iter.Seq2[V, error]) ([]V, error) {
+	var (
+		vals []V
+		errs []error
+	)
+	for v, err := range seq {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		vals = append(vals, v)
+	}
+	return vals, errors.Join(errs...)
+}
+
+// StopOnError returns a [iter.Seq] that yields the values of seq until the
+// first error, and a function returning that error, or nil if there was no
+// error, like [bufio.Scanner.Err]. The function should be called after
+// iteration has finished.
+func StopOnError[V any](seq iter.Seq2[V, error]) (iter.Seq[V], func() error) {
+	var firstErr error
+	values := func(yield func(V) bool) {
+		firstErr = nil
+		for v, err := range seq {
+			if err != nil {
+				firstErr = err
+				return
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+	return values, func() error { return firstErr }
+}
+
+// PanicError is the error yielded by [Recover] when a sequence panics.
+type PanicError struct {
+	// Value is the value the sequence panicked with.
+	Value any
+	// Stack is the stack trace of the panic, see [debug.Stack].
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("sequence panicked: %v", e.Value)
+}
+
+// Unwrap returns Value if it is an error, otherwise nil.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// Recover returns a fallible [iter.Seq2] that yields the values of seq. If
+// seq panics, the panic is recovered and a [*PanicError] is yielded as the
+// last element instead. Panics raised while handling a yielded value, i.e.
+// in the body of the caller's loop, are not recovered.
+func Recover[V any](seq iter.Seq[V]) iter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		var panicErr *PanicError
+		stopped := false
+		func() {
+			inYield := false
+			defer func() {
+				if inYield {
+					// the panic came from the caller, let it continue
+					return
+				}
+				if r := recover(); r != nil {
+					panicErr = &PanicError{Value: r, Stack: debug.Stack()}
+				}
+			}()
+
+			for v := range seq {
+				inYield = true
+				ok := yield(v, nil)
+				inYield = false
+				if !ok {
+					stopped = true
+					return
+				}
+			}
+		}()
+
+		if panicErr != nil && !stopped {
+			var zero V
+			yield(zero, panicErr)
+		}
+	}
+}
+
+// Must returns a [iter.Seq] that yields the values of seq, panicking with
+// the error if seq yields one. It's intended for scripts and tests where an
+// error is unexpected.
+func Must[V any](seq iter.Seq2[V, error]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v, err := range seq {
+			if err != nil {
+				panic(err)
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// IgnoreErrors returns a [iter.Seq] that yields the values of seq, dropping
+// any errors.
+func IgnoreErrors[V any](seq iter.Seq2[V, error]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v, err := range seq {
+			if err == nil && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ChainErr is like [Chain] but stops after yielding the first error from
+// any of seqs, so later sequences aren't started.
+func ChainErr[V any](seqs ...iter.Seq2[V, error]) iter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		for _, seq := range seqs {
+			for v, err := range seq {
+				if !yield(v, err) || err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ZipErr returns a fallible [iter.Seq2] that yields pairs of values from
+// seq1 and seq2, until either is exhausted. If either yields an error, it
+// is yielded and iteration stops.
+func ZipErr[V1 any, V2 any](
+	seq1 iter.Seq2[V1, error],
+	seq2 iter.Seq2[V2, error],
+) iter.Seq2[tuple.Pair[V1, V2], error] {
+	return func(yield func(tuple.Pair[V1, V2], error) bool) {
+		next1, stop1 := iter.Pull2(seq1)
+		next2, stop2 := iter.Pull2(seq2)
+		defer stop1()
+		defer stop2()
+
+		var zero tuple.Pair[V1, V2]
+		for {
+			v1, err, ok := next1()
+			if !ok {
+				return
+			}
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			v2, err, ok := next2()
+			if !ok {
+				return
+			}
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			if !yield(tuple.NewPair(v1, v2), nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package itererr_test
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/matthewhughes934/go-itertools/itertools/itererr"
+)
+
+// lines is a simple fallible source for the examples
+func lines(s string) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		scanner := bufio.NewScanner(strings.NewReader(s))
+		for scanner.Scan() {
+			if !yield(scanner.Text(), nil) {
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			yield("", err)
+		}
+	}
+}
+
+func ExampleFromSeq() {
+	for v, err := range itererr.FromSeq(slices.Values([]int{1, 2})) {
+		fmt.Println(v, err)
+	}
+
+	// output:
+	// 1 <nil>
+	// 2 <nil>
+}
+
+func ExampleMap() {
+	for v, err := range itererr.Map(strings.ToUpper, lines("a\nb")) {
+		fmt.Println(v, err)
+	}
+
+	// output:
+	// A <nil>
+	// B <nil>
+}
+
+func ExampleFilter() {
+	nonEmpty := func(s string) bool { return s != "" }
+
+	for v, err := range itererr.Filter(nonEmpty, lines("a\n\nb")) {
+		fmt.Println(v, err)
+	}
+
+	// output:
+	// a <nil>
+	// b <nil>
+}
+
+func ExampleTake() {
+	for v, err := range itererr.Take(lines("a\nb\nc"), 2) {
+		fmt.Println(v, err)
+	}
+
+	// output:
+	// a <nil>
+	// b <nil>
+}
+
+func ExampleChain() {
+	numbers := itererr.Map(strconv.Itoa, itererr.FromSeq(slices.Values([]int{1, 2})))
+
+	for v, err := range itererr.Chain(lines("a"), numbers) {
+		fmt.Println(v, err)
+	}
+
+	// output:
+	// a <nil>
+	// 1 <nil>
+	// 2 <nil>
+}
+
+func ExampleTryMap() {
+	for v, err := range itererr.TryMap(strconv.Atoi, slices.Values([]string{"1", "two", "3"})) {
+		fmt.Println(v, err)
+	}
+
+	// output:
+	// 1 <nil>
+	// 0 strconv.Atoi: parsing "two": invalid syntax
+	// 3 <nil>
+}
+
+func ExampleTryFilter() {
+	// e.g. a check that requires I/O
+	exists := func(name string) (bool, error) {
+		if name == "" {
+			return false, errors.New("empty name")
+		}
+		return name != "bob", nil
+	}
+
+	names := slices.Values([]string{"alice", "bob", "", "carol"})
+	for v, err := range itererr.TryFilter(exists, names) {
+		fmt.Printf("%q %v\n", v, err)
+	}
+
+	// output:
+	// "alice" <nil>
+	// "" empty name
+	// "carol" <nil>
+}
+
+func ExampleTryFilter2() {
+	isNumber := func(s string) (bool, error) {
+		_, err := strconv.Atoi(s)
+		return err == nil, nil
+	}
+
+	for v, err := range itererr.TryFilter2(isNumber, lines("1\na\n2")) {
+		fmt.Println(v, err)
+	}
+
+	// output:
+	// 1 <nil>
+	// 2 <nil>
+}
+
+func ExampleTryCollect() {
+	vals, err := itererr.TryCollect(
+		itererr.TryMap(strconv.Atoi, slices.Values([]string{"1", "two", "3"})),
+	)
+
+	fmt.Println(vals, err)
+
+	// output:
+	// [1] strconv.Atoi: parsing "two": invalid syntax
+}
+
+func ExampleTryCollectAll() {
+	vals, err := itererr.TryCollectAll(
+		itererr.TryMap(strconv.Atoi, slices.Values([]string{"1", "two", "3", "four"})),
+	)
+
+	fmt.Println(vals)
+	fmt.Println(err)
+
+	// output:
+	// [1 3]
+	// strconv.Atoi: parsing "two": invalid syntax
+	// strconv.Atoi: parsing "four": invalid syntax
+}
+
+func ExampleStopOnError() {
+	numbers, errFunc := itererr.StopOnError(
+		itererr.TryMap(strconv.Atoi, slices.Values([]string{"1", "2", "three", "4"})),
+	)
+
+	for n := range numbers {
+		fmt.Println(n)
+	}
+	if err := errFunc(); err != nil {
+		fmt.Println(err)
+	}
+
+	// output:
+	// 1
+	// 2
+	// strconv.Atoi: parsing "three": invalid syntax
+}
+
+func ExampleRecover() {
+	// e.g. a third-party source with a bug
+	source := func(yield func(int) bool) {
+		vals := []int{1, 2}
+		for i := range 3 {
+			if !yield(vals[i]) {
+				return
+			}
+		}
+	}
+
+	for v, err := range itererr.Recover(source) {
+		fmt.Println(v, err)
+	}
+
+	// output:
+	// 1 <nil>
+	// 2 <nil>
+	// 0 sequence panicked: runtime error: index out of range [2] with length 2
+}
+
+func ExampleMust() {
+	for n := range itererr.Must(itererr.TryMap(strconv.Atoi, slices.Values([]string{"1", "2"}))) {
+		fmt.Println(n)
+	}
+
+	// output:
+	// 1
+	// 2
+}
+
+func ExampleIgnoreErrors() {
+	numbers := itererr.TryMap(strconv.Atoi, slices.Values([]string{"1", "two", "3"}))
+
+	fmt.Println(slices.Collect(itererr.IgnoreErrors(numbers)))
+
+	// output:
+	// [1 3]
+}
+
+func ExampleChainErr() {
+	numbers := itererr.TryMap(strconv.Atoi, slices.Values([]string{"1", "two"}))
+	more := itererr.TryMap(strconv.Atoi, slices.Values([]string{"3", "4"}))
+
+	for v, err := range itererr.ChainErr(numbers, more) {
+		fmt.Println(v, err)
+	}
+
+	// output:
+	// 1 <nil>
+	// 0 strconv.Atoi: parsing "two": invalid syntax
+}
+
+func ExampleZipErr() {
+	names := lines("alice\nbob\ncarol")
+	ages := itererr.TryMap(strconv.Atoi, slices.Values([]string{"30", "forty", "50"}))
+
+	for p, err := range itererr.ZipErr(names, ages) {
+		if err != nil {
+			fmt.Println("error:", err)
+			break
+		}
+		fmt.Println(p.First, p.Second)
+	}
+
+	// output:
+	// alice 30
+	// error: strconv.Atoi: parsing "forty": invalid syntax
+}
@@ -0,0 +1,617 @@
+package itererr_test
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+	"github.com/matthewhughes934/go-itertools/itertools/itererr"
+	"github.com/matthewhughes934/go-itertools/itertools/tuple"
+)
+
+var errTest = errors.New("test error")
+
+// fallible returns a sequence yielding items, where each item is either a
+// value or an error
+func fallible[V any](items ...any) iter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		for _, item := range items {
+			var zero V
+			switch item := item.(type) {
+			case error:
+				if !yield(zero, item) {
+					return
+				}
+			case V:
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	for _, tc := range []struct {
+		vals     []int
+		expected []tuple.Pair[int, error]
+	}{
+		{nil, nil},
+		{[]int{1, 2}, []tuple.Pair[int, error]{
+			tuple.NewPair[int, error](1, nil),
+			tuple.NewPair[int, error](2, nil),
+		}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := tuple.CollectPairs(itererr.FromSeq(slices.Values(tc.vals)))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestFromSeq_earlyStop(t *testing.T) {
+	seq := itererr.FromSeq(slices.Values([]int{1, 2}))
+
+	got := tuple.CollectPairs(itertools.SliceUntil2(seq, 1, 1))
+
+	require.Equal(t, []tuple.Pair[int, error]{tuple.NewPair[int, error](1, nil)}, got)
+}
+
+func TestMap(t *testing.T) {
+	for _, tc := range []struct {
+		items    []any
+		expected []tuple.Pair[string, error]
+	}{
+		{nil, nil},
+		{
+			[]any{1, errTest, 2},
+			[]tuple.Pair[string, error]{
+				tuple.NewPair[string, error]("1", nil),
+				tuple.NewPair("", errTest),
+				tuple.NewPair[string, error]("2", nil),
+			},
+		},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := tuple.CollectPairs(itererr.Map(strconv.Itoa, fallible[int](tc.items...)))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestMap_earlyStop(t *testing.T) {
+	for _, tc := range []struct {
+		items []any
+	}{
+		{[]any{1, 2}},
+		{[]any{errTest, 2}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			seq := itererr.Map(strconv.Itoa, fallible[int](tc.items...))
+
+			got := tuple.CollectPairs(itertools.SliceUntil2(seq, 1, 1))
+
+			require.Len(t, got, 1)
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+	for _, tc := range []struct {
+		items    []any
+		expected []tuple.Pair[int, error]
+	}{
+		{nil, nil},
+		{
+			[]any{1, 2, errTest, 3, 4},
+			[]tuple.Pair[int, error]{
+				tuple.NewPair[int, error](2, nil),
+				tuple.NewPair(0, errTest),
+				tuple.NewPair[int, error](4, nil),
+			},
+		},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := tuple.CollectPairs(itererr.Filter(isEven, fallible[int](tc.items...)))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestFilter_earlyStop(t *testing.T) {
+	seq := itererr.Filter(func(int) bool { return true }, fallible[int](1, 2))
+
+	got := tuple.CollectPairs(itertools.SliceUntil2(seq, 1, 1))
+
+	require.Equal(t, []tuple.Pair[int, error]{tuple.NewPair[int, error](1, nil)}, got)
+}
+
+func TestTake(t *testing.T) {
+	for _, tc := range []struct {
+		items    []any
+		n        int
+		expected []tuple.Pair[int, error]
+	}{
+		{nil, 0, nil},
+		{nil, 2, nil},
+		{[]any{1, 2}, 0, nil},
+		{[]any{1, 2, 3}, 2, []tuple.Pair[int, error]{
+			tuple.NewPair[int, error](1, nil),
+			tuple.NewPair[int, error](2, nil),
+		}},
+		{[]any{1, errTest, 2, errTest}, 2, []tuple.Pair[int, error]{
+			tuple.NewPair[int, error](1, nil),
+			tuple.NewPair(0, errTest),
+			tuple.NewPair[int, error](2, nil),
+		}},
+		{[]any{1}, 3, []tuple.Pair[int, error]{tuple.NewPair[int, error](1, nil)}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := tuple.CollectPairs(itererr.Take(fallible[int](tc.items...), tc.n))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestTake_earlyStop(t *testing.T) {
+	seq := itererr.Take(fallible[int](1, 2, 3), 2)
+
+	got := tuple.CollectPairs(itertools.SliceUntil2(seq, 1, 1))
+
+	require.Equal(t, []tuple.Pair[int, error]{tuple.NewPair[int, error](1, nil)}, got)
+}
+
+func TestTake_negative(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"n for Take must be non-negative",
+		func() { itererr.Take(fallible[int](1), -1) },
+	)
+}
+
+func TestChain(t *testing.T) {
+	for _, tc := range []struct {
+		seqs     []iter.Seq2[int, error]
+		expected []tuple.Pair[int, error]
+	}{
+		{nil, nil},
+		{[]iter.Seq2[int, error]{fallible[int]()}, nil},
+		{
+			[]iter.Seq2[int, error]{fallible[int](1, errTest), fallible[int](), fallible[int](2)},
+			[]tuple.Pair[int, error]{
+				tuple.NewPair[int, error](1, nil),
+				tuple.NewPair(0, errTest),
+				tuple.NewPair[int, error](2, nil),
+			},
+		},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := tuple.CollectPairs(itererr.Chain(tc.seqs...))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestChain_earlyStop(t *testing.T) {
+	seq := itererr.Chain(fallible[int](1), fallible[int](2))
+
+	got := tuple.CollectPairs(itertools.SliceUntil2(seq, 1, 1))
+
+	require.Equal(t, []tuple.Pair[int, error]{tuple.NewPair[int, error](1, nil)}, got)
+}
+
+func TestTryMap(t *testing.T) {
+	for _, tc := range []struct {
+		vals     []string
+		expected []tuple.Pair[int, error]
+	}{
+		{nil, nil},
+		{[]string{"1", "2"}, []tuple.Pair[int, error]{
+			tuple.NewPair[int, error](1, nil),
+			tuple.NewPair[int, error](2, nil),
+		}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := tuple.CollectPairs(itererr.TryMap(strconv.Atoi, slices.Values(tc.vals)))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestTryMap_error(t *testing.T) {
+	var errs []error
+	for _, err := range itererr.TryMap(strconv.Atoi, slices.Values([]string{"1", "x", "3"})) {
+		errs = append(errs, err)
+	}
+
+	require.Len(t, errs, 3)
+	require.NoError(t, errs[0])
+	require.ErrorIs(t, errs[1], strconv.ErrSyntax)
+	require.NoError(t, errs[2])
+}
+
+func TestTryMap_earlyStop(t *testing.T) {
+	seq := itererr.TryMap(strconv.Atoi, slices.Values([]string{"1", "2"}))
+
+	got := tuple.CollectPairs(itertools.SliceUntil2(seq, 1, 1))
+
+	require.Equal(t, []tuple.Pair[int, error]{tuple.NewPair[int, error](1, nil)}, got)
+}
+
+func isEvenOrFail(x int) (bool, error) {
+	if x < 0 {
+		return false, errTest
+	}
+	return x%2 == 0, nil
+}
+
+func TestTryFilter(t *testing.T) {
+	for _, tc := range []struct {
+		vals     []int
+		expected []tuple.Pair[int, error]
+	}{
+		{nil, nil},
+		{[]int{1, 3}, nil},
+		{[]int{1, 2, -1, 4}, []tuple.Pair[int, error]{
+			tuple.NewPair[int, error](2, nil),
+			tuple.NewPair(0, errTest),
+			tuple.NewPair[int, error](4, nil),
+		}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := tuple.CollectPairs(itererr.TryFilter(isEvenOrFail, slices.Values(tc.vals)))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestTryFilter_earlyStop(t *testing.T) {
+	seq := itererr.TryFilter(isEvenOrFail, slices.Values([]int{2, 4}))
+
+	got := tuple.CollectPairs(itertools.SliceUntil2(seq, 1, 1))
+
+	require.Equal(t, []tuple.Pair[int, error]{tuple.NewPair[int, error](2, nil)}, got)
+}
+
+func TestTryFilter2(t *testing.T) {
+	otherErr := errors.New("other")
+	for _, tc := range []struct {
+		items    []any
+		expected []tuple.Pair[int, error]
+	}{
+		{nil, nil},
+		{[]any{1, otherErr, -1, 2}, []tuple.Pair[int, error]{
+			tuple.NewPair(0, otherErr),
+			tuple.NewPair(0, errTest),
+			tuple.NewPair[int, error](2, nil),
+		}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := tuple.CollectPairs(itererr.TryFilter2(isEvenOrFail, fallible[int](tc.items...)))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestTryFilter2_earlyStop(t *testing.T) {
+	for _, tc := range []struct {
+		items []any
+	}{
+		{[]any{2, 4}},
+		{[]any{errTest, 4}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			seq := itererr.TryFilter2(isEvenOrFail, fallible[int](tc.items...))
+
+			got := tuple.CollectPairs(itertools.SliceUntil2(seq, 1, 1))
+
+			require.Len(t, got, 1)
+		})
+	}
+}
+
+func TestTryCollect(t *testing.T) {
+	for _, tc := range []struct {
+		items       []any
+		expected    []int
+		expectedErr error
+	}{
+		{nil, nil, nil},
+		{[]any{1, 2}, []int{1, 2}, nil},
+		{[]any{1, errTest, 2}, []int{1}, errTest},
+		{[]any{errTest}, nil, errTest},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got, err := itererr.TryCollect(fallible[int](tc.items...))
+
+			require.Equal(t, tc.expected, got)
+			require.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestTryCollectAll(t *testing.T) {
+	otherErr := errors.New("other")
+	for _, tc := range []struct {
+		items        []any
+		expected     []int
+		expectedErrs []error
+	}{
+		{nil, nil, nil},
+		{[]any{1, 2}, []int{1, 2}, nil},
+		{[]any{1, errTest, 2, otherErr}, []int{1, 2}, []error{errTest, otherErr}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got, err := itererr.TryCollectAll(fallible[int](tc.items...))
+
+			require.Equal(t, tc.expected, got)
+			if tc.expectedErrs == nil {
+				require.NoError(t, err)
+			}
+			for _, expectedErr := range tc.expectedErrs {
+				require.ErrorIs(t, err, expectedErr)
+			}
+		})
+	}
+}
+
+func TestStopOnError(t *testing.T) {
+	for _, tc := range []struct {
+		items       []any
+		expected    []int
+		expectedErr error
+	}{
+		{nil, nil, nil},
+		{[]any{1, 2}, []int{1, 2}, nil},
+		{[]any{1, errTest, 2}, []int{1}, errTest},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			seq, errFunc := itererr.StopOnError(fallible[int](tc.items...))
+
+			got := slices.Collect(seq)
+
+			require.Equal(t, tc.expected, got)
+			require.Equal(t, tc.expectedErr, errFunc())
+		})
+	}
+}
+
+func TestStopOnError_earlyStop(t *testing.T) {
+	seq, errFunc := itererr.StopOnError(fallible[int](1, 2, errTest))
+
+	got := slices.Collect(itertools.SliceUntil(seq, 1, 1))
+
+	require.Equal(t, []int{1}, got)
+	require.NoError(t, errFunc())
+}
+
+func TestStopOnError_reset(t *testing.T) {
+	fail := true
+	source := func(yield func(int, error) bool) {
+		if fail {
+			yield(0, errTest)
+		}
+	}
+	seq, errFunc := itererr.StopOnError(source)
+
+	require.Empty(t, slices.Collect(seq))
+	require.Equal(t, errTest, errFunc())
+	fail = false
+	require.Empty(t, slices.Collect(seq))
+	require.NoError(t, errFunc())
+}
+
+func panicsAfter[V any](value any, vals ...V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range vals {
+			if !yield(v) {
+				return
+			}
+		}
+		panic(value)
+	}
+}
+
+func TestRecover(t *testing.T) {
+	got := tuple.CollectPairs(itererr.Recover(slices.Values([]int{1, 2})))
+
+	require.Equal(t, []tuple.Pair[int, error]{
+		tuple.NewPair[int, error](1, nil),
+		tuple.NewPair[int, error](2, nil),
+	}, got)
+}
+
+func TestRecover_panic(t *testing.T) {
+	for _, tc := range []struct {
+		value       any
+		expectedErr error
+	}{
+		{"oops", nil},
+		{errTest, errTest},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := tuple.CollectPairs(itererr.Recover(panicsAfter(tc.value, 1)))
+
+			require.Len(t, got, 2)
+			require.Equal(t, tuple.NewPair[int, error](1, nil), got[0])
+			var panicErr *itererr.PanicError
+			require.ErrorAs(t, got[1].Second, &panicErr)
+			require.Equal(t, tc.value, panicErr.Value)
+			require.NotEmpty(t, panicErr.Stack)
+			require.Equal(t, fmt.Sprintf("sequence panicked: %v", tc.value), panicErr.Error())
+			require.Equal(t, tc.expectedErr, errors.Unwrap(panicErr))
+		})
+	}
+}
+
+func TestRecover_earlyStop(t *testing.T) {
+	// panics when told to stop
+	seq := func(yield func(int) bool) {
+		if !yield(1) {
+			panic("stopped")
+		}
+	}
+
+	got := tuple.CollectPairs(itertools.SliceUntil2(itererr.Recover(seq), 1, 1))
+
+	require.Equal(t, []tuple.Pair[int, error]{tuple.NewPair[int, error](1, nil)}, got)
+}
+
+func TestRecover_callerPanic(t *testing.T) {
+	require.PanicsWithValue(t, "caller", func() {
+		for range itererr.Recover(slices.Values([]int{1})) {
+			panic("caller")
+		}
+	})
+}
+
+func TestMust(t *testing.T) {
+	got := slices.Collect(itererr.Must(fallible[int](1, 2)))
+
+	require.Equal(t, []int{1, 2}, got)
+}
+
+func TestMust_error(t *testing.T) {
+	var got []int
+
+	require.PanicsWithValue(t, errTest, func() {
+		for v := range itererr.Must(fallible[int](1, errTest, 2)) {
+			got = append(got, v)
+		}
+	})
+	require.Equal(t, []int{1}, got)
+}
+
+func TestMust_earlyStop(t *testing.T) {
+	got := slices.Collect(itertools.SliceUntil(itererr.Must(fallible[int](1, errTest)), 1, 1))
+
+	require.Equal(t, []int{1}, got)
+}
+
+func TestIgnoreErrors(t *testing.T) {
+	for _, tc := range []struct {
+		items    []any
+		expected []int
+	}{
+		{nil, nil},
+		{[]any{errTest}, nil},
+		{[]any{1, errTest, 2, errTest}, []int{1, 2}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := slices.Collect(itererr.IgnoreErrors(fallible[int](tc.items...)))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestIgnoreErrors_earlyStop(t *testing.T) {
+	seq := itererr.IgnoreErrors(fallible[int](errTest, 1, 2))
+
+	got := slices.Collect(itertools.SliceUntil(seq, 1, 1))
+
+	require.Equal(t, []int{1}, got)
+}
+
+func TestChainErr(t *testing.T) {
+	otherErr := errors.New("other")
+	for _, tc := range []struct {
+		seqs     []iter.Seq2[int, error]
+		expected []tuple.Pair[int, error]
+	}{
+		{nil, nil},
+		{
+			[]iter.Seq2[int, error]{fallible[int](1), fallible[int](), fallible[int](2)},
+			[]tuple.Pair[int, error]{
+				tuple.NewPair[int, error](1, nil),
+				tuple.NewPair[int, error](2, nil),
+			},
+		},
+		{
+			[]iter.Seq2[int, error]{fallible[int](1, errTest, 2), fallible[int](otherErr)},
+			[]tuple.Pair[int, error]{
+				tuple.NewPair[int, error](1, nil),
+				tuple.NewPair(0, errTest),
+			},
+		},
+		{
+			[]iter.Seq2[int, error]{fallible[int](1), fallible[int](errTest), fallible[int](2)},
+			[]tuple.Pair[int, error]{
+				tuple.NewPair[int, error](1, nil),
+				tuple.NewPair(0, errTest),
+			},
+		},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := tuple.CollectPairs(itererr.ChainErr(tc.seqs...))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestChainErr_earlyStop(t *testing.T) {
+	seq := itererr.ChainErr(fallible[int](1), fallible[int](2))
+
+	got := tuple.CollectPairs(itertools.SliceUntil2(seq, 1, 1))
+
+	require.Equal(t, []tuple.Pair[int, error]{tuple.NewPair[int, error](1, nil)}, got)
+}
+
+func TestZipErr(t *testing.T) {
+	otherErr := errors.New("other")
+	for _, tc := range []struct {
+		first    []any
+		second   []any
+		expected []tuple.Pair[tuple.Pair[int, string], error]
+	}{
+		{nil, nil, nil},
+		{[]any{1, 2}, []any{"a"}, []tuple.Pair[tuple.Pair[int, string], error]{
+			tuple.NewPair[tuple.Pair[int, string], error](tuple.NewPair(1, "a"), nil),
+		}},
+		{[]any{1}, []any{"a", "b"}, []tuple.Pair[tuple.Pair[int, string], error]{
+			tuple.NewPair[tuple.Pair[int, string], error](tuple.NewPair(1, "a"), nil),
+		}},
+		{[]any{1, errTest}, []any{"a", otherErr}, []tuple.Pair[tuple.Pair[int, string], error]{
+			tuple.NewPair[tuple.Pair[int, string], error](tuple.NewPair(1, "a"), nil),
+			tuple.NewPair(tuple.Pair[int, string]{}, errTest),
+		}},
+		{[]any{1, 2}, []any{"a", otherErr}, []tuple.Pair[tuple.Pair[int, string], error]{
+			tuple.NewPair[tuple.Pair[int, string], error](tuple.NewPair(1, "a"), nil),
+			tuple.NewPair(tuple.Pair[int, string]{}, otherErr),
+		}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			seq := itererr.ZipErr(fallible[int](tc.first...), fallible[string](tc.second...))
+
+			got := tuple.CollectPairs(seq)
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestZipErr_earlyStop(t *testing.T) {
+	seq := itererr.ZipErr(fallible[int](1, 2), fallible[string]("a", "b"))
+
+	got := tuple.CollectPairs(itertools.SliceUntil2(seq, 1, 1))
+
+	require.Equal(t, []tuple.Pair[tuple.Pair[int, string], error]{
+		tuple.NewPair[tuple.Pair[int, string], error](tuple.NewPair(1, "a"), nil),
+	}, got)
+}
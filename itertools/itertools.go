@@ -2,17 +2,40 @@
 // [iter].
 // It is inspired by the [Python itertools package]
 //
+// Some functions, such as [Map] and [Filter], take their function argument
+// before the sequence. The seqfirst subpackage provides these with the
+// sequence first, consistent with the rest of the package.
+//
 // [Python itertools package]: https://docs.python.org/3/library/itertools.html
 package itertools
 
 import (
+	"cmp"
 	"context"
+	"hash"
 	"iter"
 	"maps"
+	"math"
+	"reflect"
 	"slices"
+	"strconv"
+	"strings"
 	"sync"
+
+	"github.com/matthewhughes934/go-itertools/itertools/tuple"
 )
 
+// SeqOf returns a [iter.Seq] that yields vals. It is a convenience for
+// writing small sequences inline.
+func SeqOf[V any](vals ...V) iter.Seq[V] {
+	return slices.Values(vals)
+}
+
+// Seq2Of returns a [iter.Seq2] that yields the values held in each of pairs.
+func Seq2Of[K comparable, V any](pairs ...tuple.Pair[K, V]) iter.Seq2[K, V] {
+	return FromPairs(slices.Values(pairs))
+}
+
 // Chain returns a [iter.Seq] that returns elements from the first sequence
 // until it is exhausted, then proceeds to the next sequence, until all
 // sequences are exhausted.
@@ -103,6 +126,48 @@ func Map2[K1 comparable, V1 any, K2 comparable, V2 any](
 	}
 }
 
+// MapKeys returns a [iter.Seq2] that applies mapFunc to every key of seq,
+// yielding the results with the original values.
+func MapKeys[K1 comparable, K2 comparable, V any](
+	mapFunc func(K1) K2,
+	seq iter.Seq2[K1, V],
+) iter.Seq2[K2, V] {
+	return func(yield func(K2, V) bool) {
+		for k, v := range seq {
+			if !yield(mapFunc(k), v) {
+				return
+			}
+		}
+	}
+}
+
+// MapValues returns a [iter.Seq2] that applies mapFunc to every value of seq,
+// yielding the results with the original keys.
+func MapValues[K comparable, V1 any, V2 any](
+	mapFunc func(V1) V2,
+	seq iter.Seq2[K, V1],
+) iter.Seq2[K, V2] {
+	return func(yield func(K, V2) bool) {
+		for k, v := range seq {
+			if !yield(k, mapFunc(v)) {
+				return
+			}
+		}
+	}
+}
+
+// Invert returns a [iter.Seq2] that yields the pairs of seq with the key and
+// value swapped.
+func Invert[K comparable, V comparable](seq iter.Seq2[K, V]) iter.Seq2[V, K] {
+	return func(yield func(V, K) bool) {
+		for k, v := range seq {
+			if !yield(v, k) {
+				return
+			}
+		}
+	}
+}
+
 // Filter returns a [iter.Seq] from those elements of seq for which filterFunc is true.
 func Filter[V any](filterFunc func(V) bool, seq iter.Seq[V]) iter.Seq[V] {
 	return func(yield func(V) bool) {
@@ -142,6 +207,36 @@ func Enumerate[V any](seq iter.Seq[V], start int) iter.Seq2[int, V] {
 	}
 }
 
+// Enumerate2 is like [Enumerate] but for [iter.Seq2], yielding a count and
+// each pair of values of seq as a [tuple.Pair].
+func Enumerate2[K comparable, V any](
+	seq iter.Seq2[K, V],
+	start int,
+) iter.Seq2[int, tuple.Pair[K, V]] {
+	return Enumerate(ToPairs(seq), start)
+}
+
+// KeyBy returns a [iter.Seq2] that yields each value of seq paired with the
+// key returned by calling keyFunc on it.
+func KeyBy[K comparable, V any](seq iter.Seq[V], keyFunc func(V) K) iter.Seq2[K, V] {
+	return Associate(seq, func(v V) (K, V) { return keyFunc(v), v })
+}
+
+// Associate returns a [iter.Seq2] that yields the pairs returned by calling
+// function on each value of seq.
+func Associate[V1 any, K comparable, V2 any](
+	seq iter.Seq[V1],
+	function func(V1) (K, V2),
+) iter.Seq2[K, V2] {
+	return func(yield func(K, V2) bool) {
+		for v := range seq {
+			if !yield(function(v)) {
+				return
+			}
+		}
+	}
+}
+
 // AnyFunc returns true if checker returns true for any element in seq,
 // otherwise it returns false.
 func AnyFunc[V any](checker func(V) bool, seq iter.Seq[V]) bool {
@@ -194,6 +289,62 @@ func FirstFunc2[K comparable, V any]( //nolint:ireturn
 	return zeroK, zeroV, false
 }
 
+// LastFunc returns the last value in seq for which checker returns true and
+// 'true' or the zero value for type V and 'false' if no element of seq
+// satisfies checker.
+//
+// Unlike [FirstFunc], LastFunc always iterates over all of seq, so seq must
+// be finite.
+func LastFunc[V any](checker func(V) bool, seq iter.Seq[V]) (V, bool) { //nolint:ireturn
+	var last V
+	found := false
+	for v := range seq {
+		if checker(v) {
+			last, found = v, true
+		}
+	}
+	return last, found
+}
+
+// IndexFunc returns the index of the first value in seq for which checker
+// returns true, or -1 if no element of seq satisfies checker.
+func IndexFunc[V any](checker func(V) bool, seq iter.Seq[V]) int {
+	for i, v := range Enumerate(seq, 0) {
+		if checker(v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// LastIndexFunc returns the index of the last value in seq for which checker
+// returns true, or -1 if no element of seq satisfies checker.
+//
+// Like [LastFunc], seq must be finite.
+func LastIndexFunc[V any](checker func(V) bool, seq iter.Seq[V]) int {
+	last := -1
+	for i, v := range Enumerate(seq, 0) {
+		if checker(v) {
+			last = i
+		}
+	}
+	return last
+}
+
+// Positions returns a [iter.Seq] that yields the index of every value in seq
+// for which checker returns true.
+func Positions[V any](checker func(V) bool, seq iter.Seq[V]) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i, v := range Enumerate(seq, 0) {
+			if checker(v) {
+				if !yield(i) {
+					return
+				}
+			}
+		}
+	}
+}
+
 // AllFunc returns true if checker returns true for all values in seq
 // otherwise it returns false.
 func AllFunc[V any](checker func(V) bool, seq iter.Seq[V]) bool {
@@ -327,14 +478,26 @@ func ZipLongest[V any](fillValue V, seqs ...iter.Seq[V]) iter.Seq[V] {
 }
 
 // Range returns a [iter.Seq] that yields values step distance apart from start
-// until end, not including end.
+// until end, not including end. See [RangeOf] for other integer types.
 //
 // Range panics if step is 0.
 func Range(start int, end int, step int) iter.Seq[int] {
 	if step == 0 {
 		panic("step for Range must be non-zero")
 	}
-	return func(yield func(int) bool) {
+	return RangeOf(start, end, step)
+}
+
+// RangeOf is like [Range] but works with any integer type, e.g.
+//
+//	RangeOf[uint8](0, 255, 5)
+//
+// RangeOf panics if step is 0.
+func RangeOf[T Integer](start T, end T, step T) iter.Seq[T] {
+	if step == 0 {
+		panic("step for RangeOf must be non-zero")
+	}
+	return func(yield func(T) bool) {
 		length := getRangeLen(start, end, step)
 		for x := start; length > 0; x += step {
 			if !yield(x) {
@@ -345,11 +508,16 @@ func Range(start int, end int, step int) iter.Seq[int] {
 	}
 }
 
-func getRangeLen(start int, end int, step int) int {
+// getRangeLen returns the number of values in the given range. The
+// calculation is done with uint64 so it can't overflow even when the
+// distance between start and end doesn't fit in T: converting a signed value
+// to uint64 sign-extends it, so the difference of two converted values (mod
+// 2^64) is still the true difference.
+func getRangeLen[T Integer](start T, end T, step T) uint64 {
 	if step > 0 && start < end {
-		return 1 + (end-start-1)/step
+		return 1 + (uint64(end)-uint64(start)-1)/uint64(step)
 	} else if step < 0 && start > end {
-		return 1 + (start-end-1)/-step
+		return 1 + (uint64(start)-uint64(end)-1)/-uint64(step)
 	} else {
 		return 0
 	}
@@ -357,7 +525,12 @@ func getRangeLen(start int, end int, step int) int {
 
 // Range from is like [Range] but has no end.
 func RangeFrom(start int, step int) iter.Seq[int] {
-	return func(yield func(int) bool) {
+	return RangeFromOf(start, step)
+}
+
+// RangeFromOf is like [RangeFrom] but works with any integer type.
+func RangeFromOf[T Integer](start T, step T) iter.Seq[T] {
+	return func(yield func(T) bool) {
 		for x := start; ; x += step {
 			if !yield(x) {
 				return
@@ -373,6 +546,11 @@ func RangeUntil(end int, step int) iter.Seq[int] {
 	return Range(0, end, step)
 }
 
+// RangeUntilOf is like [RangeUntil] but works with any integer type.
+func RangeUntilOf[T Integer](end T, step T) iter.Seq[T] {
+	return RangeOf(0, end, step)
+}
+
 // Cycle returns a [iter.Seq] that returns elements from the iterable and saves a copy of each.
 // When the iterable is exhausted, elements from the saved copy are returned.
 // Repeats indefinitely.
@@ -396,24 +574,19 @@ func Cycle[V any](iterable iter.Seq[V]) iter.Seq[V] {
 	}
 }
 
-type seq2Store[K comparable, V any] struct {
-	k K
-	v V
-}
-
 func Cycle2[K comparable, V any](iterable iter.Seq2[K, V]) iter.Seq2[K, V] {
 	return func(yield func(K, V) bool) {
-		var saved []seq2Store[K, V]
+		var saved []tuple.Pair[K, V]
 		for k, v := range iterable {
 			if !yield(k, v) {
 				return
 			}
-			saved = append(saved, seq2Store[K, V]{k, v})
+			saved = append(saved, tuple.NewPair(k, v))
 		}
 
 		for {
 			for _, s := range saved {
-				if !yield(s.k, s.v) {
+				if !yield(s.First, s.Second) {
 					return
 				}
 			}
@@ -440,6 +613,55 @@ func Repeat[V any](value V, times int) iter.Seq[V] {
 	}
 }
 
+// RepeatFunc is like [Repeat] but yields the result of calling function each
+// time, rather than a fixed value.
+func RepeatFunc[V any](function func() V, times int) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for i := 0; times < 0 || i < times; i++ {
+			if !yield(function()) {
+				return
+			}
+		}
+	}
+}
+
+// Iterate returns a [iter.Seq] that yields x, function(x),
+// function(function(x)) and so on, indefinitely.
+func Iterate[V any](x V, function func(V) V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v := x; ; v = function(v) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Unfold returns a [iter.Seq] that yields values produced by repeatedly
+// calling function, starting with state. Each call returns a value, the
+// state to pass to the next call and whether a value was produced; the
+// sequence ends the first time function returns false, without yielding the
+// value from that call.
+//
+// Each iteration over the returned sequence starts again from state.
+func Unfold[S any, V any](state S, function func(S) (V, S, bool)) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for s := state; ; {
+			v, next, ok := function(s)
+			if !ok || !yield(v) {
+				return
+			}
+			s = next
+		}
+	}
+}
+
+// Tabulate returns a [iter.Seq] that yields function(start),
+// function(start+1) and so on, indefinitely.
+func Tabulate[V any](function func(int) V, start int) iter.Seq[V] {
+	return Map(function, RangeFrom(start, 1))
+}
+
 // Accumulate returns a [iter.Seq] that returns accumulated results from
 // function.
 // The function should accept two arguments, an accumulated total and a value
@@ -591,6 +813,21 @@ func CollectIntoMap[K comparable, V any](seq iter.Seq2[K, V], dest map[K]V) {
 	}
 }
 
+// CollectIntoMultiMap is like [CollectIntoMap] but appends each value to the
+// slice for its key, rather than overwriting earlier values with the same key.
+func CollectIntoMultiMap[K comparable, V any](seq iter.Seq2[K, V], dest map[K][]V) {
+	for k, v := range seq {
+		dest[k] = append(dest[k], v)
+	}
+}
+
+// CollectMultiMap is like [CollectIntoMultiMap] but collects into a new map.
+func CollectMultiMap[K comparable, V any](seq iter.Seq2[K, V]) map[K][]V {
+	dest := map[K][]V{}
+	CollectIntoMultiMap(seq, dest)
+	return dest
+}
+
 // Keys returns a [iter.Seq] over the keys of seq.
 func Keys[K comparable, V any](seq iter.Seq2[K, V]) iter.Seq[K] {
 	return func(yield func(K) bool) {
@@ -613,40 +850,120 @@ func Values[K comparable, V any](seq iter.Seq2[K, V]) iter.Seq[V] {
 	}
 }
 
+// UniqueKeys returns a [iter.Seq2] that yields only the first pair of seq for
+// each key, skipping any later pairs with the same key.
+func UniqueKeys[K comparable, V any](seq iter.Seq2[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		seen := map[K]struct{}{}
+		for k, v := range seq {
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// UniqueValues is like [UniqueKeys] but yields only the first pair of seq for
+// each value.
+func UniqueValues[K comparable, V comparable](seq iter.Seq2[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		seen := map[V]struct{}{}
+		for k, v := range seq {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// ToPairs returns a [iter.Seq] that yields each pair of values of seq as a
+// [tuple.Pair].
+func ToPairs[K comparable, V any](seq iter.Seq2[K, V]) iter.Seq[tuple.Pair[K, V]] {
+	return func(yield func(tuple.Pair[K, V]) bool) {
+		for k, v := range seq {
+			if !yield(tuple.NewPair(k, v)) {
+				return
+			}
+		}
+	}
+}
+
+// FromPairs returns a [iter.Seq2] that is the inverse of [ToPairs], yielding
+// the values held in each [tuple.Pair] of seq.
+func FromPairs[K comparable, V any](seq iter.Seq[tuple.Pair[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for p := range seq {
+			if !yield(p.First, p.Second) {
+				return
+			}
+		}
+	}
+}
+
 // IterCtx returns a [iter.Seq] that yields values from seq until either
-// seq is exhausted or ctx is cancelled, whichever comes first.
+// seq is exhausted or ctx is cancelled, whichever comes first. Iteration
+// ends as soon as ctx is cancelled, even if seq is blocked producing a
+// value, in which case seq is stopped in the background once it produces
+// one.
 func IterCtx[V any](ctx context.Context, seq iter.Seq[V]) iter.Seq[V] {
 	return func(yield func(V) bool) {
-		res := make(chan V)
-		next, stop := iter.Pull(seq)
+		iterCtx(ctx, seq, yield)
+	}
+}
 
-		// 'next' and 'stop' must not be called from multiple gorountines
-		// simultaneously
-		var pullMutex sync.Mutex
-		defer func() {
+// iterCtx implements [IterCtx], returning whether iteration stopped because
+// ctx was cancelled.
+func iterCtx[V any](ctx context.Context, seq iter.Seq[V], yield func(V) bool) bool {
+	// buffered so a pending pull doesn't block forever once ctx is
+	// cancelled and nothing receives its result
+	res := make(chan V, 1)
+	next, stop := iter.Pull(seq)
+
+	// 'next' and 'stop' must not be called from multiple gorountines
+	// simultaneously
+	var pullMutex sync.Mutex
+	stopLocked := func() {
+		pullMutex.Lock()
+		defer pullMutex.Unlock()
+		stop()
+	}
+	cancelled := false
+	defer func() {
+		// a pull may still be waiting on seq, so stop it in the background
+		// rather than wait for seq to produce a value
+		if cancelled {
+			go stopLocked()
+		} else {
+			stopLocked()
+		}
+	}()
+
+	for {
+		var ok bool
+		var v V
+		go func() {
 			pullMutex.Lock()
 			defer pullMutex.Unlock()
-			stop()
+			v, ok = next()
+			res <- v
 		}()
 
-		for {
-			var ok bool
-			var v V
-			go func() {
-				pullMutex.Lock()
-				defer pullMutex.Unlock()
-				v, ok = next()
-				res <- v
-			}()
-
-			select {
-			case v := <-res:
-				if !ok || !yield(v) {
-					return
-				}
-			case <-ctx.Done():
-				return
+		select {
+		case v := <-res:
+			if !ok || !yield(v) {
+				return false
 			}
+		case <-ctx.Done():
+			cancelled = true
+			return true
 		}
 	}
 }
@@ -654,16 +971,26 @@ func IterCtx[V any](ctx context.Context, seq iter.Seq[V]) iter.Seq[V] {
 // IterCtx2 is like [IterCtx] but for [iter.Seq2] sequences.
 func IterCtx2[K comparable, V any](ctx context.Context, seq iter.Seq2[K, V]) iter.Seq2[K, V] {
 	return func(yield func(K, V) bool) {
-		res := make(chan seq2Store[K, V])
+		// buffered, see IterCtx
+		res := make(chan tuple.Pair[K, V], 1)
 		next, stop := iter.Pull2(seq)
 
 		// 'next' and 'stop' must not be called from multiple gorountines
 		// simultaneously
 		var pullMutex sync.Mutex
-		defer func() {
+		stopLocked := func() {
 			pullMutex.Lock()
 			defer pullMutex.Unlock()
 			stop()
+		}
+		cancelled := false
+		defer func() {
+			// see IterCtx
+			if cancelled {
+				go stopLocked()
+			} else {
+				stopLocked()
+			}
 		}()
 
 		for {
@@ -674,21 +1001,37 @@ func IterCtx2[K comparable, V any](ctx context.Context, seq iter.Seq2[K, V]) ite
 				pullMutex.Lock()
 				defer pullMutex.Unlock()
 				k, v, ok = next()
-				res <- seq2Store[K, V]{k, v}
+				res <- tuple.NewPair(k, v)
 			}()
 
 			select {
 			case s := <-res:
-				if !ok || !yield(s.k, s.v) {
+				if !ok || !yield(s.First, s.Second) {
 					return
 				}
 			case <-ctx.Done():
+				cancelled = true
 				return
 			}
 		}
 	}
 }
 
+// IterCtxErr is like [IterCtx] but also returns a function reporting why
+// iteration stopped: it returns ctx's error if iteration was stopped because
+// ctx was cancelled, or nil if seq was exhausted or the caller stopped
+// iterating. The function should be called after iteration has finished.
+func IterCtxErr[V any](ctx context.Context, seq iter.Seq[V]) (iter.Seq[V], func() error) {
+	var err error
+	values := func(yield func(V) bool) {
+		err = nil
+		if iterCtx(ctx, seq, yield) {
+			err = ctx.Err()
+		}
+	}
+	return values, func() error { return err }
+}
+
 // Slice returns a [iter.Seq] that slices up the provided sequence: returning
 // elements step distance apart from start until end (excluding end).
 //
@@ -816,3 +1159,1011 @@ func Pairwise[V comparable](seq iter.Seq[V]) iter.Seq2[V, V] {
 		}
 	}
 }
+
+// SplitAt returns two sequences: the first yields the first n elements of seq
+// and the second yields the remaining elements.
+//
+// seq is only iterated over once, so it may be a single-use sequence. The
+// first n elements are buffered, so the second sequence may be consumed
+// before the first. The resources held for seq are only released once the
+// second sequence has been iterated over, so it should always be iterated
+// over, even if only to stop immediately. The two sequences must not be
+// iterated over concurrently.
+//
+// SplitAt panics if n is negative.
+func SplitAt[V any](seq iter.Seq[V], n int) (iter.Seq[V], iter.Seq[V]) {
+	if n < 0 {
+		panic("n for SplitAt must be non-negative")
+	}
+
+	var next func() (V, bool)
+	var stop func()
+	pull := func() (V, bool) {
+		if next == nil {
+			next, stop = iter.Pull(seq)
+		}
+		return next()
+	}
+
+	var head []V
+	first := func(yield func(V) bool) {
+		for i := range n {
+			if i == len(head) {
+				v, ok := pull()
+				if !ok {
+					return
+				}
+				head = append(head, v)
+			}
+			if !yield(head[i]) {
+				return
+			}
+		}
+	}
+
+	second := func(yield func(V) bool) {
+		defer func() {
+			if stop != nil {
+				stop()
+			}
+		}()
+
+		for len(head) < n {
+			v, ok := pull()
+			if !ok {
+				return
+			}
+			head = append(head, v)
+		}
+
+		for {
+			v, ok := pull()
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}
+
+	return first, second
+}
+
+// Span returns two sequences: the first yields the leading elements of seq
+// for which predicate is true and the second yields the remaining elements.
+//
+// It is equivalent to [TakeWhile] and [DropWhile] with the same predicate,
+// except seq is only iterated over once, so it may be a single-use sequence.
+// Like [SplitAt], the leading elements are buffered so the second sequence
+// may be consumed before the first, the second sequence should always be
+// iterated over, and the two sequences must not be iterated over concurrently.
+func Span[V any](seq iter.Seq[V], predicate func(V) bool) (iter.Seq[V], iter.Seq[V]) {
+	var next func() (V, bool)
+	var stop func()
+	pull := func() (V, bool) {
+		if next == nil {
+			next, stop = iter.Pull(seq)
+		}
+		return next()
+	}
+
+	var head []V
+	headDone := false
+	// the first element not satisfying predicate, which belongs to the
+	// second sequence
+	var boundary V
+	hasBoundary := false
+	pullHead := func() bool {
+		if headDone {
+			return false
+		}
+
+		v, ok := pull()
+		if ok && predicate(v) {
+			head = append(head, v)
+			return true
+		}
+
+		headDone = true
+		boundary, hasBoundary = v, ok
+		return false
+	}
+
+	first := func(yield func(V) bool) {
+		for i := 0; i < len(head) || pullHead(); i++ {
+			if !yield(head[i]) {
+				return
+			}
+		}
+	}
+
+	second := func(yield func(V) bool) {
+		defer func() {
+			if stop != nil {
+				stop()
+			}
+		}()
+
+		for !headDone {
+			pullHead()
+		}
+
+		if hasBoundary {
+			hasBoundary = false
+			if !yield(boundary) {
+				return
+			}
+		}
+
+		for {
+			v, ok := pull()
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}
+
+	return first, second
+}
+
+// Equal reports whether seq1 and seq2 yield the same values in the same
+// order. It stops iterating as soon as a difference is found.
+func Equal[V comparable](seq1 iter.Seq[V], seq2 iter.Seq[V]) bool {
+	return EqualFunc(seq1, seq2, func(v1 V, v2 V) bool { return v1 == v2 })
+}
+
+// EqualFunc is like [Equal] but uses eq to compare each pair of values.
+func EqualFunc[V1 any, V2 any](seq1 iter.Seq[V1], seq2 iter.Seq[V2], eq func(V1, V2) bool) bool {
+	next, stop := iter.Pull(seq2)
+	defer stop()
+
+	for v1 := range seq1 {
+		v2, ok := next()
+		if !ok || !eq(v1, v2) {
+			return false
+		}
+	}
+	_, ok := next()
+	return !ok
+}
+
+// Equal2 is like [Equal] but for [iter.Seq2].
+func Equal2[K comparable, V comparable](seq1 iter.Seq2[K, V], seq2 iter.Seq2[K, V]) bool {
+	return EqualFunc2(
+		seq1,
+		seq2,
+		func(k1 K, v1 V, k2 K, v2 V) bool { return k1 == k2 && v1 == v2 },
+	)
+}
+
+// EqualFunc2 is like [EqualFunc] but for [iter.Seq2].
+func EqualFunc2[K1 comparable, V1 any, K2 comparable, V2 any](
+	seq1 iter.Seq2[K1, V1],
+	seq2 iter.Seq2[K2, V2],
+	eq func(K1, V1, K2, V2) bool,
+) bool {
+	next, stop := iter.Pull2(seq2)
+	defer stop()
+
+	for k1, v1 := range seq1 {
+		k2, v2, ok := next()
+		if !ok || !eq(k1, v1, k2, v2) {
+			return false
+		}
+	}
+	_, _, ok := next()
+	return !ok
+}
+
+// Compare compares the values of seq1 and seq2 lexicographically, using
+// [cmp.Compare] on each pair of values in turn. The result is 0 if seq1 ==
+// seq2, -1 if seq1 < seq2, and +1 if seq1 > seq2. If one sequence is a prefix
+// of the other then the shorter sequence is considered the lesser. It stops
+// iterating as soon as a difference is found.
+func Compare[V cmp.Ordered](seq1 iter.Seq[V], seq2 iter.Seq[V]) int {
+	return CompareFunc(seq1, seq2, cmp.Compare[V])
+}
+
+// CompareFunc is like [Compare] but uses compare to compare each pair of
+// values. The result is the first non-zero result of compare, or the result
+// of comparing the lengths of the sequences if they are equal up to the length
+// of the shorter sequence.
+func CompareFunc[V1 any, V2 any](
+	seq1 iter.Seq[V1],
+	seq2 iter.Seq[V2],
+	compare func(V1, V2) int,
+) int {
+	next, stop := iter.Pull(seq2)
+	defer stop()
+
+	for v1 := range seq1 {
+		v2, ok := next()
+		if !ok {
+			return 1
+		}
+		if c := compare(v1, v2); c != 0 {
+			return c
+		}
+	}
+	if _, ok := next(); ok {
+		return -1
+	}
+	return 0
+}
+
+// StartsWith reports whether the first values of seq are the values of
+// prefix. It stops iterating as soon as a difference is found, so seq may be
+// infinite, but prefix must be finite.
+func StartsWith[V comparable](seq iter.Seq[V], prefix iter.Seq[V]) bool {
+	next, stop := iter.Pull(seq)
+	defer stop()
+
+	for p := range prefix {
+		v, ok := next()
+		if !ok || v != p {
+			return false
+		}
+	}
+	return true
+}
+
+// EndsWith reports whether the last values of seq are the values of suffix.
+// Both sequences must be finite. Only the last values of seq are buffered, so
+// the memory used is proportional to the length of suffix, not seq.
+func EndsWith[V comparable](seq iter.Seq[V], suffix iter.Seq[V]) bool {
+	want := slices.Collect(suffix)
+	if len(want) == 0 {
+		return true
+	}
+
+	// a ring buffer of the most recent values in seq
+	window := make([]V, len(want))
+	count := 0
+	for v := range seq {
+		window[count%len(window)] = v
+		count++
+	}
+	if count < len(want) {
+		return false
+	}
+
+	for i, w := range want {
+		if window[(count+i)%len(window)] != w {
+			return false
+		}
+	}
+	return true
+}
+
+// AllEqual returns true if every value in seq is equal to the first value,
+// otherwise it returns false. It returns true if seq is empty and stops
+// iterating as soon as a different value is found.
+func AllEqual[V comparable](seq iter.Seq[V]) bool {
+	return AllEqualFunc(func(v1 V, v2 V) bool { return v1 == v2 }, seq)
+}
+
+// AllEqualFunc is like [AllEqual] but uses eq to compare the first value to
+// every other value.
+func AllEqualFunc[V any](eq func(V, V) bool, seq iter.Seq[V]) bool {
+	var first V
+	started := false
+	for v := range seq {
+		if !started {
+			first, started = v, true
+			continue
+		}
+		if !eq(first, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// AllUnique returns true if no value in seq is repeated, otherwise it returns
+// false. It stops iterating as soon as a repeated value is found.
+func AllUnique[V comparable](seq iter.Seq[V]) bool {
+	return AllUniqueFunc(func(v V) V { return v }, seq)
+}
+
+// AllUniqueFunc is like [AllUnique] but compares the result of calling
+// keyFunc on each value rather than the values themselves.
+func AllUniqueFunc[V any, K comparable](keyFunc func(V) K, seq iter.Seq[V]) bool {
+	seen := map[K]struct{}{}
+	for v := range seq {
+		k := keyFunc(v)
+		if _, ok := seen[k]; ok {
+			return false
+		}
+		seen[k] = struct{}{}
+	}
+	return true
+}
+
+// Pad returns a [iter.Seq] that yields the values of seq and then yields
+// fillValue indefinitely.
+func Pad[V any](seq iter.Seq[V], fillValue V) iter.Seq[V] {
+	return Chain(seq, Repeat(fillValue, -1))
+}
+
+// PadTo returns a [iter.Seq] that yields exactly n values: the values of seq,
+// followed by fillValue if seq has fewer than n values. If seq has more than n
+// values then only the first n are yielded.
+//
+// PadTo panics if n is negative.
+func PadTo[V any](seq iter.Seq[V], fillValue V, n int) iter.Seq[V] {
+	if n < 0 {
+		panic("n for PadTo must be non-negative")
+	}
+	return SliceUntil(Pad(seq, fillValue), n, 1)
+}
+
+// Prepend returns a [iter.Seq] that yields vals followed by the values of seq.
+func Prepend[V any](seq iter.Seq[V], vals ...V) iter.Seq[V] {
+	return Chain(slices.Values(vals), seq)
+}
+
+// Append returns a [iter.Seq] that yields the values of seq followed by vals.
+func Append[V any](seq iter.Seq[V], vals ...V) iter.Seq[V] {
+	return Chain(seq, slices.Values(vals))
+}
+
+// Replace returns a [iter.Seq] that yields the values of seq, except the
+// first limit values for which predicate is true are replaced with
+// replacement. If limit is negative then every such value is replaced.
+func Replace[V any](seq iter.Seq[V], predicate func(V) bool, replacement V, limit int) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		count := 0
+		for v := range seq {
+			if (limit < 0 || count < limit) && predicate(v) {
+				v = replacement
+				count++
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Rotate returns a [iter.Seq] that yields the values of seq rotated n
+// positions to the left, or -n positions to the right if n is negative. E.g.
+// rotating the values 1, 2, 3, 4 by 1 yields 2, 3, 4, 1 and by -1 yields 4,
+// 1, 2, 3.
+//
+// seq must be finite. If n is non-negative then only the first n values are
+// buffered, otherwise all values of seq are buffered.
+func Rotate[V any](seq iter.Seq[V], n int) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		var head []V
+		if n < 0 {
+			head = slices.Collect(seq)
+		} else {
+			for v := range seq {
+				if len(head) < n {
+					head = append(head, v)
+					continue
+				}
+				if !yield(v) {
+					return
+				}
+			}
+		}
+
+		if len(head) == 0 {
+			return
+		}
+		k := (n%len(head) + len(head)) % len(head)
+		for v := range ChainSlices(head[k:], head[:k]) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Reversed returns a [iter.Seq] that yields the values of seq in reverse
+// order.
+//
+// seq must be finite, and every value of seq is buffered before the first
+// value is yielded, so the memory used is proportional to the length of seq.
+// If the values are already in slices then use [ReversedSlices] instead,
+// which does no copying.
+func Reversed[V any](seq iter.Seq[V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range slices.Backward(slices.Collect(seq)) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ReversedSlices returns a [iter.Seq] that yields the values of
+// [ChainSlices] in reverse order, without copying any of the slices.
+func ReversedSlices[V any](sls ...[]V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, slice := range slices.Backward(sls) {
+			for _, v := range slices.Backward(slice) {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Sorted returns a [iter.Seq] that yields the values of seq sorted according
+// to compare, see [slices.SortFunc] for the requirements of compare. The sort
+// is stable.
+//
+// Nothing is done until the returned sequence is iterated over, at which
+// point all the values of seq are collected and sorted, so seq must be
+// finite.
+func Sorted[V any](seq iter.Seq[V], compare func(V, V) int) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range slices.SortedStableFunc(seq, compare) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// RunLengthEncode returns a [iter.Seq2] that collapses runs of consecutive
+// equal values in seq into pairs of the value and the length of the run.
+func RunLengthEncode[V comparable](seq iter.Seq[V]) iter.Seq2[V, int] {
+	return func(yield func(V, int) bool) {
+		var current V
+		count := 0
+		for v := range seq {
+			if count > 0 && v == current {
+				count++
+				continue
+			}
+			if count > 0 && !yield(current, count) {
+				return
+			}
+			current, count = v, 1
+		}
+
+		if count > 0 {
+			yield(current, count)
+		}
+	}
+}
+
+// RunLengthDecode returns a [iter.Seq] that is the inverse of
+// [RunLengthEncode]: yielding each value of seq as many times as its count.
+func RunLengthDecode[V any](seq iter.Seq2[V, int]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v, count := range seq {
+			for range count {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Diffs returns a [iter.Seq] that yields the result of calling sub on each
+// value of seq and the value before it, i.e. sub(seq[1], seq[0]), sub(seq[2],
+// seq[1]) and so on. It is the inverse of [Accumulate] when function adds
+// values.
+//
+// It will be empty if seq has fewer than two values.
+func Diffs[V any, D any](seq iter.Seq[V], sub func(cur V, prev V) D) iter.Seq[D] {
+	return func(yield func(D) bool) {
+		var prev V
+		started := false
+		for v := range seq {
+			if started && !yield(sub(v, prev)) {
+				return
+			}
+			prev, started = v, true
+		}
+	}
+}
+
+// Integer is a constraint that permits any integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is a constraint that permits any floating-point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	Integer | Float
+}
+
+// WindowReduce returns a [iter.Seq] that yields the result of reducing each
+// window of n consecutive values of seq with function, starting from
+// initial. I.e. for each window it yields function(...function(function(
+// initial, w[0]), w[1])..., w[n-1]). It will be empty if seq has fewer than n
+// values.
+//
+// Each window is reduced from scratch, so this costs n calls to function per
+// value of seq. The window is kept in a ring buffer, which only saves
+// allocating a slice per window.
+//
+// WindowReduce panics if n is not a positive integer.
+func WindowReduce[V any, A any](
+	seq iter.Seq[V],
+	n int,
+	function func(acc A, val V) A,
+	initial A,
+) iter.Seq[A] {
+	if n <= 0 {
+		panic("n for WindowReduce must be a positive integer")
+	}
+	return func(yield func(A) bool) {
+		window := make([]V, n)
+		count := 0
+		for v := range seq {
+			window[count%n] = v
+			count++
+			if count < n {
+				continue
+			}
+
+			acc := initial
+			for i := range n {
+				acc = function(acc, window[(count+i)%n])
+			}
+			if !yield(acc) {
+				return
+			}
+		}
+	}
+}
+
+// MovingAverage returns a [iter.Seq] that yields the mean of each window of n
+// consecutive values of seq. It will be empty if seq has fewer than n values.
+//
+// Unlike [WindowReduce] it keeps a running total of the window, so each value
+// is only added and removed once. The total is kept in float64, so small
+// integer types can't overflow, and with compensated summation, so a large
+// value leaving the window doesn't leave behind rounding error.
+//
+// MovingAverage panics if n is not a positive integer.
+func MovingAverage[V Number](seq iter.Seq[V], n int) iter.Seq[float64] {
+	if n <= 0 {
+		panic("n for MovingAverage must be a positive integer")
+	}
+	return func(yield func(float64) bool) {
+		window := make([]V, n)
+		var total compensatedSum
+		count := 0
+		for v := range seq {
+			total.add(float64(v))
+			total.add(-float64(window[count%n]))
+			window[count%n] = v
+			count++
+			if count < n {
+				continue
+			}
+
+			if !yield(total.value() / float64(n)) {
+				return
+			}
+		}
+	}
+}
+
+// compensatedSum is a running sum using Neumaier's variant of Kahan
+// summation, tracking the low-order bits lost by each addition.
+type compensatedSum struct {
+	sum          float64
+	compensation float64
+}
+
+func (s *compensatedSum) add(x float64) {
+	t := s.sum + x
+	if math.Abs(s.sum) >= math.Abs(x) {
+		s.compensation += (s.sum - t) + x
+	} else {
+		s.compensation += (x - t) + s.sum
+	}
+	s.sum = t
+}
+
+func (s *compensatedSum) value() float64 {
+	return s.sum + s.compensation
+}
+
+// Collapse returns a [iter.Seq] that recursively flattens the values of seq:
+// every value that is a slice, array or sequence (i.e. has the same
+// underlying type as [iter.Seq] for some type) is replaced by its elements,
+// down to maxDepth levels of nesting. All other values, including strings,
+// slices and arrays of bytes, and maps, are yielded as-is. If maxDepth is
+// negative there is no limit to the depth of the flattening.
+//
+// Since seq can contain values of any type, Collapse relies on reflection so
+// it is slower than more specific functions like [Chain].
+func Collapse(seq iter.Seq[any], maxDepth int) iter.Seq[any] {
+	return func(yield func(any) bool) {
+		for v := range seq {
+			if !collapse(v, maxDepth, yield) {
+				return
+			}
+		}
+	}
+}
+
+func collapse(v any, depth int, yield func(any) bool) bool {
+	if depth == 0 {
+		return yield(v)
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() { //nolint:exhaustive
+	case reflect.Slice, reflect.Array:
+		// like strings, bytes are treated as single values
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		for i := range rv.Len() {
+			if !collapse(rv.Index(i).Interface(), depth-1, yield) {
+				return false
+			}
+		}
+		return true
+	case reflect.Func:
+		if !rv.Type().CanSeq() {
+			break
+		}
+		if rv.IsNil() {
+			return true
+		}
+		for elem := range rv.Seq() {
+			if !collapse(elem.Interface(), depth-1, yield) {
+				return false
+			}
+		}
+		return true
+	}
+	return yield(v)
+}
+
+// SortedByKey returns a [iter.Seq2] that yields the pairs of seq sorted by
+// key. Like [Sorted], the sort is stable and nothing is done until the
+// returned sequence is iterated over, at which point all the pairs of seq are
+// collected, so seq must be finite.
+func SortedByKey[K cmp.Ordered, V any](seq iter.Seq2[K, V]) iter.Seq2[K, V] {
+	return SortedByKeyFunc(seq, cmp.Compare[K])
+}
+
+// SortedByKeyFunc is like [SortedByKey] but sorts the keys according to
+// compare.
+func SortedByKeyFunc[K comparable, V any](
+	seq iter.Seq2[K, V],
+	compare func(K, K) int,
+) iter.Seq2[K, V] {
+	return sortedPairs(seq, func(p1 tuple.Pair[K, V], p2 tuple.Pair[K, V]) int {
+		return compare(p1.First, p2.First)
+	})
+}
+
+// SortedByValue is like [SortedByKey] but sorts the pairs by value.
+func SortedByValue[K comparable, V cmp.Ordered](seq iter.Seq2[K, V]) iter.Seq2[K, V] {
+	return SortedByValueFunc(seq, cmp.Compare[V])
+}
+
+// SortedByValueFunc is like [SortedByValue] but sorts the values according to
+// compare.
+func SortedByValueFunc[K comparable, V any](
+	seq iter.Seq2[K, V],
+	compare func(V, V) int,
+) iter.Seq2[K, V] {
+	return sortedPairs(seq, func(p1 tuple.Pair[K, V], p2 tuple.Pair[K, V]) int {
+		return compare(p1.Second, p2.Second)
+	})
+}
+
+func sortedPairs[K comparable, V any](
+	seq iter.Seq2[K, V],
+	compare func(tuple.Pair[K, V], tuple.Pair[K, V]) int,
+) iter.Seq2[K, V] {
+	return FromPairs(Sorted(ToPairs(seq), compare))
+}
+
+// Join returns a [iter.Seq2] that performs an inner join of left and right on
+// their keys: for every pair of left and every pair of right with the same
+// key, it yields the key and a [tuple.Pair] of the two values. The results
+// are in the order of left, then in the order of right for each key.
+//
+// All the pairs of right are collected into a map before any values are
+// yielded, so right must be finite, but left is iterated over lazily.
+func Join[K comparable, L any, R any](
+	left iter.Seq2[K, L],
+	right iter.Seq2[K, R],
+) iter.Seq2[K, tuple.Pair[L, R]] {
+	return func(yield func(K, tuple.Pair[L, R]) bool) {
+		rights := CollectMultiMap(right)
+		for k, l := range left {
+			for _, r := range rights[k] {
+				if !yield(k, tuple.NewPair(l, r)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// LeftJoin is like [Join] but performs a left outer join: pairs of left with
+// no matching key in right are also yielded, with fillValue in place of the
+// value from right.
+func LeftJoin[K comparable, L any, R any](
+	left iter.Seq2[K, L],
+	right iter.Seq2[K, R],
+	fillValue R,
+) iter.Seq2[K, tuple.Pair[L, R]] {
+	return func(yield func(K, tuple.Pair[L, R]) bool) {
+		rights := CollectMultiMap(right)
+		for k, l := range left {
+			rs, ok := rights[k]
+			if !ok {
+				rs = []R{fillValue}
+			}
+			for _, r := range rs {
+				if !yield(k, tuple.NewPair(l, r)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// OuterJoin is like [LeftJoin] but performs a full outer join: after all the
+// pairs of left have been joined, pairs of right with no matching key in left
+// are yielded with leftFillValue in place of the value from left. These are
+// yielded in the order their keys first appear in right.
+func OuterJoin[K comparable, L any, R any](
+	left iter.Seq2[K, L],
+	right iter.Seq2[K, R],
+	leftFillValue L,
+	rightFillValue R,
+) iter.Seq2[K, tuple.Pair[L, R]] {
+	return func(yield func(K, tuple.Pair[L, R]) bool) {
+		rights := map[K][]R{}
+		var rightKeys []K
+		for k, r := range right {
+			if _, ok := rights[k]; !ok {
+				rightKeys = append(rightKeys, k)
+			}
+			rights[k] = append(rights[k], r)
+		}
+
+		matched := map[K]struct{}{}
+		for k, l := range left {
+			rs, ok := rights[k]
+			if ok {
+				matched[k] = struct{}{}
+			} else {
+				rs = []R{rightFillValue}
+			}
+			for _, r := range rs {
+				if !yield(k, tuple.NewPair(l, r)) {
+					return
+				}
+			}
+		}
+
+		for _, k := range rightKeys {
+			if _, ok := matched[k]; ok {
+				continue
+			}
+			for _, r := range rights[k] {
+				if !yield(k, tuple.NewPair(leftFillValue, r)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// CrossJoin returns a [iter.Seq2] that yields every combination of a value
+// from seq1 with a value from seq2, in the order of seq1 then seq2.
+//
+// seq2 is iterated over once, while the first value of seq1 is being joined,
+// and its values are buffered for the remaining values of seq1, so seq2 must
+// be finite.
+func CrossJoin[V1 any, V2 any](seq1 iter.Seq[V1], seq2 iter.Seq[V2]) iter.Seq2[V1, V2] {
+	return func(yield func(V1, V2) bool) {
+		var saved []V2
+		first := true
+		for v1 := range seq1 {
+			if first {
+				first = false
+				for v2 := range seq2 {
+					saved = append(saved, v2)
+					if !yield(v1, v2) {
+						return
+					}
+				}
+				continue
+			}
+
+			for _, v2 := range saved {
+				if !yield(v1, v2) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// EditOp is the kind of operation performed by an [Edit].
+type EditOp int
+
+const (
+	// EditKeep keeps a value that is in both sequences.
+	EditKeep EditOp = iota
+	// EditDelete deletes a value that is only in the first sequence.
+	EditDelete
+	// EditInsert inserts a value that is only in the second sequence.
+	EditInsert
+)
+
+func (op EditOp) String() string {
+	switch op {
+	case EditKeep:
+		return "keep"
+	case EditDelete:
+		return "delete"
+	case EditInsert:
+		return "insert"
+	}
+	return "EditOp(" + strconv.Itoa(int(op)) + ")"
+}
+
+// Edit is a single step of an edit script, as returned by [Diff].
+type Edit[V any] struct {
+	Op    EditOp
+	Value V
+}
+
+// Diff returns a [iter.Seq] that yields a shortest edit script transforming
+// the values of seq1 into the values of seq2, i.e. applying the yielded
+// edits in order to seq1 will give seq2. Where there are multiple shortest
+// scripts, deletions are yielded before insertions.
+//
+// Diff uses the Myers diff algorithm. Both sequences are collected and the
+// script computed when the returned sequence is iterated over, so both must
+// be finite. For sequences of lengths N and M with D differences, this takes
+// O((N+M)D) time and memory.
+func Diff[V comparable](seq1 iter.Seq[V], seq2 iter.Seq[V]) iter.Seq[Edit[V]] {
+	return func(yield func(Edit[V]) bool) {
+		for _, e := range myersDiff(slices.Collect(seq1), slices.Collect(seq2)) {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+func myersDiff[V comparable](a []V, b []V) []Edit[V] {
+	n, m := len(a), len(b)
+	// v[offset+k] is the furthest x reached on diagonal k = x - y
+	offset := n + m
+	v := make([]int, 2*offset+2)
+	// trace[d] is the state of v before searching with d differences
+	var trace [][]int
+
+	down := func(v []int, d int, k int) bool {
+		return k == -d || (k != d && v[offset+k-1] < v[offset+k+1])
+	}
+
+search:
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, slices.Clone(v))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if down(v, d, k) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	var edits []Edit[V]
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		k := x - y
+		prevK := k - 1
+		if down(v, d, k) {
+			prevK = k + 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			edits = append(edits, Edit[V]{EditKeep, a[x-1]})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				edits = append(edits, Edit[V]{EditInsert, b[y-1]})
+			} else {
+				edits = append(edits, Edit[V]{EditDelete, a[x-1]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	slices.Reverse(edits)
+	return edits
+}
+
+// LCS returns a longest common subsequence of the values of seq1 and seq2,
+// i.e. the longest sequence of values that appear in both, in the same order
+// but not necessarily consecutively.
+//
+// It is the values kept by the edit script returned by [Diff], so it has the
+// same requirements and costs.
+func LCS[V comparable](seq1 iter.Seq[V], seq2 iter.Seq[V]) []V {
+	var res []V
+	for e := range Diff(seq1, seq2) {
+		if e.Op == EditKeep {
+			res = append(res, e.Value)
+		}
+	}
+	return res
+}
+
+// JoinString concatenates the values of seq, with sep placed between each,
+// like [strings.Join] but without first collecting seq into a slice.
+func JoinString(seq iter.Seq[string], sep string) string {
+	var b strings.Builder
+	first := true
+	for s := range seq {
+		if !first {
+			b.WriteString(sep)
+		}
+		first = false
+		b.WriteString(s)
+	}
+	return b.String()
+}
+
+// HashSeq writes each value of seq to h and returns the resulting digest,
+// see [hash.Hash.Sum]. Values are written as they're produced, so a digest
+// can be computed for data that doesn't fit in memory.
+func HashSeq(h hash.Hash, seq iter.Seq[[]byte]) ([]byte, error) {
+	return HashSeqFunc(h, seq, func(b []byte) ([]byte, error) { return b, nil })
+}
+
+// HashSeqFunc is like [HashSeq] but for a sequence of any type, using encode
+// to convert each value to the bytes written to h. It stops at the first
+// error, from either encode or h, returning that error.
+func HashSeqFunc[V any](
+	h hash.Hash,
+	seq iter.Seq[V],
+	encode func(V) ([]byte, error),
+) ([]byte, error) {
+	for v := range seq {
+		b, err := encode(v)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := h.Write(b); err != nil {
+			return nil, err
+		}
+	}
+	return h.Sum(nil), nil
+}
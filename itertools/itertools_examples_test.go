@@ -2,14 +2,45 @@ package itertools_test
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"iter"
 	"maps"
+	"math"
 	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/matthewhughes934/go-itertools/itertools"
+	"github.com/matthewhughes934/go-itertools/itertools/tuple"
 )
 
+func ExampleSeqOf() {
+	for n := range itertools.SeqOf(1, 2, 3) {
+		fmt.Println(n)
+	}
+
+	// output:
+	// 1
+	// 2
+	// 3
+}
+
+func ExampleSeq2Of() {
+	seq := itertools.Seq2Of(tuple.NewPair("one", 1), tuple.NewPair("two", 2))
+
+	for k, v := range seq {
+		fmt.Println(k, v)
+	}
+
+	// output:
+	// one 1
+	// two 2
+}
+
 func ExampleChain() {
 	seqs := []iter.Seq[int]{
 		slices.Values([]int{1, 2, 3}),
@@ -120,6 +151,43 @@ func ExampleMap2() {
 	// baz halved 1.5
 }
 
+func ExampleMapKeys() {
+	seq := maps.All(map[string]int{"foo": 1, "bar": 2})
+
+	for k, v := range itertools.MapKeys(strings.ToUpper, seq) {
+		fmt.Println(k, v)
+	}
+
+	// unordered output:
+	// FOO 1
+	// BAR 2
+}
+
+func ExampleMapValues() {
+	seq := maps.All(map[string]string{"foo": "1", "bar": "2"})
+
+	for k, v := range itertools.MapValues(strconv.Quote, seq) {
+		fmt.Println(k, v)
+	}
+
+	// unordered output:
+	// foo "1"
+	// bar "2"
+}
+
+func ExampleInvert() {
+	seq := maps.All(map[string]int{"one": 1, "two": 2, "three": 3})
+
+	for n, s := range itertools.Invert(seq) {
+		fmt.Println(n, s)
+	}
+
+	// unordered output:
+	// 1 one
+	// 2 two
+	// 3 three
+}
+
 func ExampleFilter() {
 	seq := slices.Values([]int{1, 2, 3, 4, 5})
 
@@ -179,6 +247,52 @@ func ExampleEnumerate() {
 	// 9 17
 }
 
+func ExampleEnumerate2() {
+	seq := itertools.ZipPair(
+		slices.Values([]string{"foo", "bar", "baz"}),
+		slices.Values([]int{10, 20, 30}),
+	)
+
+	for i, p := range itertools.Enumerate2(seq, 1) {
+		fmt.Println(i, p.First, p.Second)
+	}
+
+	// output:
+	// 1 foo 10
+	// 2 bar 20
+	// 3 baz 30
+}
+
+func ExampleKeyBy() {
+	type user struct {
+		id   int
+		name string
+	}
+	users := slices.Values([]user{{1, "alice"}, {2, "bob"}})
+
+	byID := maps.Collect(itertools.KeyBy(users, func(u user) int { return u.id }))
+
+	fmt.Println(byID[2].name)
+
+	// output:
+	// bob
+}
+
+func ExampleAssociate() {
+	lines := slices.Values([]string{"foo=1", "bar=2"})
+
+	for k, v := range itertools.Associate(lines, func(s string) (string, string) {
+		k, v, _ := strings.Cut(s, "=")
+		return k, v
+	}) {
+		fmt.Println(k, v)
+	}
+
+	// output:
+	// foo 1
+	// bar 2
+}
+
 func isEven(i int) bool { return i%2 == 0 }
 func isOdd(i int) bool  { return i%2 == 1 }
 
@@ -235,6 +349,54 @@ func ExampleFirstFunc() {
 	// 0 false
 }
 
+func ExampleIndexFunc() {
+	seq := slices.Values([]string{"foo", "bar", "baz"})
+
+	fmt.Println(itertools.IndexFunc(func(s string) bool { return s[0] == 'b' }, seq))
+	fmt.Println(itertools.IndexFunc(func(s string) bool { return s[0] == 'z' }, seq))
+
+	// output:
+	// 1
+	// -1
+}
+
+func ExamplePositions() {
+	seq := slices.Values([]string{"foo", "bar", "wat", "baz"})
+
+	for i := range itertools.Positions(func(s string) bool { return s[0] == 'b' }, seq) {
+		fmt.Println(i)
+	}
+
+	// output:
+	// 1
+	// 3
+}
+
+func ExampleLastFunc() {
+	nums := itertools.RangeUntil(5, 1)
+
+	x, found := itertools.LastFunc(func(x int) bool { return x < 3 }, nums)
+	fmt.Println(x, found)
+
+	x, found = itertools.LastFunc(func(x int) bool { return x > 10 }, nums)
+	fmt.Println(x, found)
+
+	// output:
+	// 2 true
+	// 0 false
+}
+
+func ExampleLastIndexFunc() {
+	seq := slices.Values([]string{"foo", "bar", "baz", "wat"})
+
+	fmt.Println(itertools.LastIndexFunc(func(s string) bool { return s[0] == 'b' }, seq))
+	fmt.Println(itertools.LastIndexFunc(func(s string) bool { return s[0] == 'z' }, seq))
+
+	// output:
+	// 2
+	// -1
+}
+
 func ExampleFirstFunc2() {
 	seq := itertools.ZipPair(
 		slices.Values([]string{"one", "two", "three", "four"}),
@@ -411,6 +573,85 @@ func ExampleRepeat() {
 	// A
 }
 
+func ExampleRepeatFunc() {
+	var ids []string
+	nextID := func() string {
+		id := fmt.Sprintf("id-%d", len(ids))
+		ids = append(ids, id)
+		return id
+	}
+
+	for id := range itertools.RepeatFunc(nextID, 3) {
+		fmt.Println(id)
+	}
+
+	// output:
+	// id-0
+	// id-1
+	// id-2
+}
+
+func ExampleIterate() {
+	// Newton's method for the square root of 2
+	seq := itertools.Iterate(1.0, func(x float64) float64 { return (x + 2/x) / 2 })
+
+	for x := range itertools.SliceUntil(seq, 5, 1) {
+		fmt.Printf("%.6f\n", x)
+	}
+
+	// output:
+	// 1.000000
+	// 1.500000
+	// 1.416667
+	// 1.414216
+	// 1.414214
+}
+
+func ExampleUnfold() {
+	pages := map[string][]string{
+		"":   {"a", "b"},
+		"p2": {"c"},
+		"p3": {"d", "e"},
+	}
+	nextToken := map[string]string{"": "p2", "p2": "p3"}
+
+	fetch := func(token *string) ([]string, *string, bool) {
+		if token == nil {
+			return nil, nil, false
+		}
+		next, ok := nextToken[*token]
+		if !ok {
+			return pages[*token], nil, true
+		}
+		return pages[*token], &next, true
+	}
+
+	start := ""
+	for page := range itertools.Unfold(&start, fetch) {
+		fmt.Println(page)
+	}
+
+	// output:
+	// [a b]
+	// [c]
+	// [d e]
+}
+
+func ExampleTabulate() {
+	squares := itertools.Tabulate(func(i int) int { return i * i }, 1)
+
+	for n := range itertools.SliceUntil(squares, 5, 1) {
+		fmt.Println(n)
+	}
+
+	// output:
+	// 1
+	// 4
+	// 9
+	// 16
+	// 25
+}
+
 func ExampleAllFunc() {
 	seq := slices.Values([]int{1, 2, 3, 4, 5})
 
@@ -604,6 +845,99 @@ func ExampleCollectIntoMap_concatMaps() {
 	// F 6
 }
 
+func ExampleUniqueKeys() {
+	events := itertools.ZipPair(
+		slices.Values([]string{"alice", "bob", "alice", "carol", "bob"}),
+		slices.Values([]string{"login", "login", "logout", "login", "logout"}),
+	)
+
+	for user, event := range itertools.UniqueKeys(events) {
+		fmt.Println(user, event)
+	}
+
+	// output:
+	// alice login
+	// bob login
+	// carol login
+}
+
+func ExampleUniqueValues() {
+	seq := slices.All([]string{"A", "B", "A", "C", "B"})
+
+	for i, s := range itertools.UniqueValues(seq) {
+		fmt.Println(i, s)
+	}
+
+	// output:
+	// 0 A
+	// 1 B
+	// 3 C
+}
+
+func ExampleToPairs() {
+	seq := slices.All([]string{"A", "B", "C"})
+
+	pairs := itertools.ToPairs(seq)
+	for p := range itertools.Reversed(pairs) {
+		fmt.Println(p.First, p.Second)
+	}
+
+	// output:
+	// 2 C
+	// 1 B
+	// 0 A
+}
+
+func ExampleFromPairs() {
+	pairs := slices.Values([]tuple.Pair[string, int]{
+		tuple.NewPair("one", 1),
+		tuple.NewPair("two", 2),
+	})
+
+	for k, v := range itertools.FromPairs(pairs) {
+		fmt.Println(k, v)
+	}
+
+	// output:
+	// one 1
+	// two 2
+}
+
+func ExampleCollectIntoMultiMap() {
+	dest := map[string][]int{"odd": {-1}}
+	seq := itertools.KeyBy(itertools.Range(1, 6, 1), func(x int) string {
+		if isOdd(x) {
+			return "odd"
+		}
+		return "even"
+	})
+
+	itertools.CollectIntoMultiMap(seq, dest)
+
+	fmt.Println(dest["odd"])
+	fmt.Println(dest["even"])
+
+	// output:
+	// [-1 1 3 5]
+	// [2 4]
+}
+
+func ExampleCollectMultiMap() {
+	words := slices.Values([]string{"apple", "bob", "avocado", "banana", "cherry"})
+	firstLetter := func(s string) byte { return s[0] }
+
+	byLetter := itertools.CollectMultiMap(itertools.KeyBy(words, firstLetter))
+
+	fmt.Println(byLetter['a'])
+	fmt.Println(byLetter['b'])
+	fmt.Println(byLetter['c'])
+
+	// output:
+	// [apple avocado]
+	// [bob banana]
+	// [cherry]
+}
+
 func ExampleIterCtx() {
 	seq := itertools.Repeat("iterating", -1)
 	ctx, cancel := context.WithCancel(context.Background())
@@ -646,6 +980,26 @@ func ExampleIterCtx2() {
 	// 4 iterating
 }
 
+func ExampleIterCtxErr() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	slow := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			time.Sleep(time.Millisecond)
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	seq, errFunc := itertools.IterCtxErr(ctx, slow)
+	_ = slices.Collect(seq)
+	fmt.Println(errFunc())
+
+	// output:
+	// context deadline exceeded
+}
+
 func ExampleSlice() {
 	seq := slices.Values([]string{"A", "B", "C", "D", "E", "F", "G", "H"})
 
@@ -747,6 +1101,38 @@ func ExampleRange() {
 	// 8
 }
 
+func ExampleRangeOf() {
+	for b := range itertools.RangeOf[byte]('a', 'f', 2) {
+		fmt.Println(string(b))
+	}
+
+	// output:
+	// a
+	// c
+	// e
+}
+
+func ExampleRangeFromOf() {
+	for n := range itertools.RangeFromOf[int64](math.MaxInt64-2, 1) {
+		fmt.Println(n)
+		if n == math.MaxInt64 {
+			break
+		}
+	}
+
+	// output:
+	// 9223372036854775805
+	// 9223372036854775806
+	// 9223372036854775807
+}
+
+func ExampleRangeUntilOf() {
+	fmt.Println(slices.Collect(itertools.RangeUntilOf[uint16](10, 4)))
+
+	// output:
+	// [0 4 8]
+}
+
 func ExampleRangeUntil() {
 	for n := range itertools.RangeUntil(10, 3) {
 		fmt.Println(n)
@@ -802,3 +1188,617 @@ func ExamplePairwise() {
 	// E F
 	// F G
 }
+
+func ExampleSplitAt() {
+	first, second := itertools.SplitAt(slices.Values([]string{"A", "B", "C", "D", "E"}), 2)
+
+	for s := range first {
+		fmt.Println("first", s)
+	}
+	for s := range second {
+		fmt.Println("second", s)
+	}
+
+	// output:
+	// first A
+	// first B
+	// second C
+	// second D
+	// second E
+}
+
+func ExampleSpan() {
+	seq := slices.Values([]int{1, 4, 6, 3, 8})
+	predicate := func(i int) bool { return i < 5 }
+
+	first, second := itertools.Span(seq, predicate)
+
+	for n := range first {
+		fmt.Println("first", n)
+	}
+	for n := range second {
+		fmt.Println("second", n)
+	}
+
+	// output:
+	// first 1
+	// first 4
+	// second 6
+	// second 3
+	// second 8
+}
+
+func ExampleEqual() {
+	seq := slices.Values([]int{1, 2, 3})
+
+	fmt.Println(itertools.Equal(seq, itertools.Range(1, 4, 1)))
+	fmt.Println(itertools.Equal(seq, itertools.Range(1, 5, 1)))
+
+	// output:
+	// true
+	// false
+}
+
+func ExampleEqualFunc() {
+	ints := slices.Values([]int{1, 2, 3})
+	strs := slices.Values([]string{"1", "2", "3"})
+
+	fmt.Println(
+		itertools.EqualFunc(ints, strs, func(i int, s string) bool { return strconv.Itoa(i) == s }),
+	)
+
+	// output:
+	// true
+}
+
+func ExampleEqual2() {
+	vals := []string{"A", "B", "C"}
+	seq := slices.All(vals)
+
+	fmt.Println(itertools.Equal2(seq, itertools.Enumerate(slices.Values(vals), 0)))
+	fmt.Println(itertools.Equal2(seq, itertools.Enumerate(slices.Values(vals), 1)))
+
+	// output:
+	// true
+	// false
+}
+
+func ExampleEqualFunc2() {
+	seq1 := itertools.ZipPair(
+		slices.Values([]string{"one", "two"}),
+		slices.Values([]int{1, 2}),
+	)
+	seq2 := itertools.ZipPair(
+		slices.Values([]string{"ONE", "TWO"}),
+		slices.Values([]int{1, 2}),
+	)
+
+	fmt.Println(itertools.EqualFunc2(
+		seq1,
+		seq2,
+		func(k1 string, v1 int, k2 string, v2 int) bool {
+			return strings.EqualFold(k1, k2) && v1 == v2
+		},
+	))
+
+	// output:
+	// true
+}
+
+func ExampleCompare() {
+	version := func(s string) iter.Seq[int] {
+		return itertools.Map(
+			func(s string) int {
+				n, _ := strconv.Atoi(s)
+				return n
+			},
+			slices.Values(strings.Split(s, ".")),
+		)
+	}
+
+	fmt.Println(itertools.Compare(version("1.2.10"), version("1.2.9")))
+	fmt.Println(itertools.Compare(version("1.2"), version("1.2.0")))
+	fmt.Println(itertools.Compare(version("1.2.3"), version("1.2.3")))
+
+	// output:
+	// 1
+	// -1
+	// 0
+}
+
+func ExampleCompareFunc() {
+	seq1 := slices.Values([]string{"foo", "BAR"})
+	seq2 := slices.Values([]string{"FOO", "bar"})
+	compareFold := func(s1 string, s2 string) int {
+		return strings.Compare(strings.ToLower(s1), strings.ToLower(s2))
+	}
+
+	fmt.Println(itertools.CompareFunc(seq1, seq2, compareFold))
+
+	// output:
+	// 0
+}
+
+func ExampleStartsWith() {
+	tokens := slices.Values([]string{"HELLO", "name", "version", "END"})
+
+	fmt.Println(itertools.StartsWith(tokens, slices.Values([]string{"HELLO"})))
+	fmt.Println(itertools.StartsWith(tokens, slices.Values([]string{"GOODBYE"})))
+
+	// output:
+	// true
+	// false
+}
+
+func ExampleEndsWith() {
+	tokens := slices.Values([]string{"HELLO", "name", "version", "END"})
+
+	fmt.Println(itertools.EndsWith(tokens, slices.Values([]string{"version", "END"})))
+	fmt.Println(itertools.EndsWith(tokens, slices.Values([]string{"name", "END"})))
+
+	// output:
+	// true
+	// false
+}
+
+func ExampleAllEqual() {
+	fmt.Println(itertools.AllEqual(slices.Values([]string{"A", "A", "A"})))
+	fmt.Println(itertools.AllEqual(slices.Values([]string{"A", "A", "B"})))
+
+	// output:
+	// true
+	// false
+}
+
+func ExampleAllEqualFunc() {
+	seq := slices.Values([]string{"go", "GO", "Go"})
+
+	fmt.Println(itertools.AllEqualFunc(strings.EqualFold, seq))
+
+	// output:
+	// true
+}
+
+func ExampleAllUnique() {
+	fmt.Println(itertools.AllUnique(slices.Values([]int{1, 2, 3})))
+	fmt.Println(itertools.AllUnique(slices.Values([]int{1, 2, 1})))
+
+	// output:
+	// true
+	// false
+}
+
+func ExampleAllUniqueFunc() {
+	ids := slices.Values([]string{"abc", "ABC", "def"})
+
+	fmt.Println(itertools.AllUniqueFunc(strings.ToLower, ids))
+
+	// output:
+	// false
+}
+
+func ExamplePad() {
+	seq := itertools.Pad(slices.Values([]string{"A", "B"}), "-")
+
+	for s := range itertools.SliceUntil(seq, 4, 1) {
+		fmt.Println(s)
+	}
+
+	// output:
+	// A
+	// B
+	// -
+	// -
+}
+
+func ExamplePadTo() {
+	record := slices.Values([]string{"id", "name"})
+
+	for s := range itertools.PadTo(record, "", 4) {
+		fmt.Printf("%q\n", s)
+	}
+
+	// output:
+	// "id"
+	// "name"
+	// ""
+	// ""
+}
+
+func ExamplePrepend() {
+	rows := slices.Values([]string{"1,foo", "2,bar"})
+
+	for s := range itertools.Prepend(rows, "id,name") {
+		fmt.Println(s)
+	}
+
+	// output:
+	// id,name
+	// 1,foo
+	// 2,bar
+}
+
+func ExampleAppend() {
+	tokens := slices.Values([]string{"HELLO", "name"})
+
+	for s := range itertools.Append(tokens, "version", "END") {
+		fmt.Println(s)
+	}
+
+	// output:
+	// HELLO
+	// name
+	// version
+	// END
+}
+
+func ExampleReplace() {
+	words := slices.Values([]string{"hello", "secret", "world", "secret"})
+	isSecret := func(s string) bool { return s == "secret" }
+
+	for s := range itertools.Replace(words, isSecret, "***", -1) {
+		fmt.Println(s)
+	}
+
+	// output:
+	// hello
+	// ***
+	// world
+	// ***
+}
+
+func ExampleRotate() {
+	seq := slices.Values([]string{"A", "B", "C", "D"})
+
+	fmt.Println(slices.Collect(itertools.Rotate(seq, 1)))
+	fmt.Println(slices.Collect(itertools.Rotate(seq, -1)))
+
+	// output:
+	// [B C D A]
+	// [D A B C]
+}
+
+func ExampleReversed() {
+	seq := itertools.Map(strings.ToUpper, slices.Values([]string{"a", "b", "c"}))
+
+	for s := range itertools.Reversed(seq) {
+		fmt.Println(s)
+	}
+
+	// output:
+	// C
+	// B
+	// A
+}
+
+func ExampleReversedSlices() {
+	slice1 := []int{1, 2, 3}
+	slice2 := []int{11, 12, 13}
+
+	for n := range itertools.ReversedSlices(slice1, slice2) {
+		fmt.Println(n)
+	}
+
+	// output:
+	// 13
+	// 12
+	// 11
+	// 3
+	// 2
+	// 1
+}
+
+func ExampleSorted() {
+	words := slices.Values([]string{"banana", "Cherry", "apple"})
+	upper := itertools.Map(strings.ToUpper, words)
+
+	for s := range itertools.Sorted(upper, strings.Compare) {
+		fmt.Println(s)
+	}
+
+	// output:
+	// APPLE
+	// BANANA
+	// CHERRY
+}
+
+func ExampleRunLengthEncode() {
+	seq := slices.Values([]string{"A", "A", "A", "B", "C", "C"})
+
+	for s, n := range itertools.RunLengthEncode(seq) {
+		fmt.Println(s, n)
+	}
+
+	// output:
+	// A 3
+	// B 1
+	// C 2
+}
+
+func ExampleRunLengthDecode() {
+	seq := itertools.ZipPair(
+		slices.Values([]string{"A", "B", "C"}),
+		slices.Values([]int{3, 1, 2}),
+	)
+
+	for s := range itertools.RunLengthDecode(seq) {
+		fmt.Println(s)
+	}
+
+	// output:
+	// A
+	// A
+	// A
+	// B
+	// C
+	// C
+}
+
+func ExampleDiffs() {
+	counter := slices.Values([]int{3, 5, 5, 12})
+	sub := func(x int, y int) int { return x - y }
+
+	for n := range itertools.Diffs(counter, sub) {
+		fmt.Println(n)
+	}
+
+	// output:
+	// 2
+	// 0
+	// 7
+}
+
+func ExampleDiffs_mixedTypes() {
+	times := slices.Values([]time.Time{
+		time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 12, 0, 30, 0, time.UTC),
+		time.Date(2024, 1, 1, 12, 2, 0, 0, time.UTC),
+	})
+
+	for d := range itertools.Diffs(times, time.Time.Sub) {
+		fmt.Println(d)
+	}
+
+	// output:
+	// 30s
+	// 1m30s
+}
+
+func ExampleWindowReduce() {
+	seq := slices.Values([]int{3, 1, 4, 1, 5, 9, 2})
+
+	maxOf := func(x int, y int) int { return max(x, y) }
+
+	for n := range itertools.WindowReduce(seq, 3, maxOf, 0) {
+		fmt.Println(n)
+	}
+
+	// output:
+	// 4
+	// 4
+	// 5
+	// 9
+	// 9
+}
+
+func ExampleMovingAverage() {
+	seq := slices.Values([]float64{1, 2, 3, 4, 5})
+
+	for n := range itertools.MovingAverage(seq, 2) {
+		fmt.Println(n)
+	}
+
+	// output:
+	// 1.5
+	// 2.5
+	// 3.5
+	// 4.5
+}
+
+func ExampleCollapse() {
+	seq := slices.Values([]any{
+		1,
+		[]int{2, 3},
+		[]any{"four", []string{"five"}},
+		slices.Values([]float64{6.5}),
+	})
+
+	fmt.Println(slices.Collect(itertools.Collapse(seq, 1)))
+	fmt.Println(slices.Collect(itertools.Collapse(seq, -1)))
+
+	// output:
+	// [1 2 3 four [five] 6.5]
+	// [1 2 3 four five 6.5]
+}
+
+func ExampleSortedByKey() {
+	seq := maps.All(map[string]int{"foo": 1, "bar": 2, "baz": 3})
+
+	for k, v := range itertools.SortedByKey(seq) {
+		fmt.Println(k, v)
+	}
+
+	// output:
+	// bar 2
+	// baz 3
+	// foo 1
+}
+
+func ExampleSortedByKeyFunc() {
+	seq := maps.All(map[string]int{"foo": 1, "bar": 2, "baz": 3})
+	reverse := func(s1 string, s2 string) int { return strings.Compare(s2, s1) }
+
+	for k, v := range itertools.SortedByKeyFunc(seq, reverse) {
+		fmt.Println(k, v)
+	}
+
+	// output:
+	// foo 1
+	// baz 3
+	// bar 2
+}
+
+func ExampleSortedByValue() {
+	seq := maps.All(map[string]int{"foo": 3, "bar": 1, "baz": 2})
+
+	for k, v := range itertools.SortedByValue(seq) {
+		fmt.Println(k, v)
+	}
+
+	// output:
+	// bar 1
+	// baz 2
+	// foo 3
+}
+
+func ExampleSortedByValueFunc() {
+	seq := maps.All(map[int]string{1: "foo", 2: "Bar", 3: "baz"})
+	compareFold := func(s1 string, s2 string) int {
+		return strings.Compare(strings.ToLower(s1), strings.ToLower(s2))
+	}
+
+	for k, v := range itertools.SortedByValueFunc(seq, compareFold) {
+		fmt.Println(k, v)
+	}
+
+	// output:
+	// 2 Bar
+	// 3 baz
+	// 1 foo
+}
+
+func ExampleJoin() {
+	users := maps.All(map[int]string{1: "alice", 2: "bob", 3: "carol"})
+	orders := itertools.ZipPair(
+		slices.Values([]int{1, 3, 1}),
+		slices.Values([]string{"book", "pen", "lamp"}),
+	)
+
+	for id, p := range itertools.Join(users, orders) {
+		fmt.Println(id, p.First, p.Second)
+	}
+
+	// unordered output:
+	// 1 alice book
+	// 1 alice lamp
+	// 3 carol pen
+}
+
+func ExampleLeftJoin() {
+	users := slices.All([]string{"alice", "bob", "carol"})
+	orders := itertools.ZipPair(
+		slices.Values([]int{0, 2, 0}),
+		slices.Values([]string{"book", "pen", "lamp"}),
+	)
+
+	for id, p := range itertools.LeftJoin(users, orders, "<none>") {
+		fmt.Println(id, p.First, p.Second)
+	}
+
+	// output:
+	// 0 alice book
+	// 0 alice lamp
+	// 1 bob <none>
+	// 2 carol pen
+}
+
+func ExampleOuterJoin() {
+	users := slices.All([]string{"alice", "bob"})
+	orders := itertools.ZipPair(
+		slices.Values([]int{0, 5}),
+		slices.Values([]string{"book", "pen"}),
+	)
+
+	for id, p := range itertools.OuterJoin(users, orders, "<unknown>", "<none>") {
+		fmt.Println(id, p.First, p.Second)
+	}
+
+	// output:
+	// 0 alice book
+	// 1 bob <none>
+	// 5 <unknown> pen
+}
+
+func ExampleCrossJoin() {
+	sizes := slices.Values([]string{"small", "large"})
+	colours := slices.Values([]string{"red", "blue"})
+
+	for size, colour := range itertools.CrossJoin(sizes, colours) {
+		fmt.Println(size, colour)
+	}
+
+	// output:
+	// small red
+	// small blue
+	// large red
+	// large blue
+}
+
+func ExampleDiff() {
+	before := slices.Values(strings.Fields("the quick brown fox"))
+	after := slices.Values(strings.Fields("the slow brown fox jumps"))
+
+	for e := range itertools.Diff(before, after) {
+		fmt.Println(e.Op, e.Value)
+	}
+
+	// output:
+	// keep the
+	// delete quick
+	// insert slow
+	// keep brown
+	// keep fox
+	// insert jumps
+}
+
+func ExampleLCS() {
+	before := slices.Values(strings.Fields("the quick brown fox"))
+	after := slices.Values(strings.Fields("the slow brown fox jumps"))
+
+	fmt.Println(itertools.LCS(before, after))
+
+	// output:
+	// [the brown fox]
+}
+
+func ExampleJoinString() {
+	words := itertools.Map(strings.ToUpper, slices.Values([]string{"a", "b", "c"}))
+
+	fmt.Println(itertools.JoinString(words, "-"))
+
+	// output:
+	// A-B-C
+}
+
+func ExampleHashSeq() {
+	chunks := slices.Values([][]byte{[]byte("hello, "), []byte("world")})
+
+	digest, err := itertools.HashSeq(sha256.New(), chunks)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Printf("%x\n", digest)
+
+	// output:
+	// 09ca7e4eaa6e8ae9c7d261167129184883644d07dfba7cbfbc4c8a2e08360d5b
+}
+
+func ExampleHashSeqFunc() {
+	type user struct {
+		Name string `json:"name"`
+	}
+	users := slices.Values([]user{{"alice"}, {"bob"}})
+
+	encode := func(u user) ([]byte, error) { return json.Marshal(u) }
+
+	digest, err := itertools.HashSeqFunc(fnv.New32a(), users, encode)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Printf("%x\n", digest)
+
+	// output:
+	// 5b13d7d6
+}
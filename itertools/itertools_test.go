@@ -1,17 +1,25 @@
 package itertools_test
 
 import (
+	"cmp"
 	"context"
+	"crypto/sha256"
+	"errors"
 	"fmt"
+	"hash"
 	"iter"
 	"maps"
+	"math"
 	"slices"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/matthewhughes934/go-itertools/itertools"
+	"github.com/matthewhughes934/go-itertools/itertools/tuple"
 )
 
 func collectPairs[K comparable](seq iter.Seq2[K, K]) [][]K {
@@ -273,6 +281,14 @@ func TestRange_panicsOnZeroStep(t *testing.T) {
 	)
 }
 
+func TestRangeOf_panicsOnZeroStep(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"step for RangeOf must be non-zero",
+		func() { itertools.RangeOf[uint8](0, 10, 0) },
+	)
+}
+
 func TestMap(t *testing.T) {
 	slice := []int{1, 2, 3}
 	expected := []string{"1", "2", "3"}
@@ -306,6 +322,41 @@ func TestMap2_earlyStop(t *testing.T) {
 	require.Equal(t, expected, got)
 }
 
+func TestMapKeys_earlyStop(t *testing.T) {
+	inSeq := itertools.Enumerate(itertools.RangeUntil(5, 1), 1)
+	takeLen := 3
+	mapFunc := func(x int) int { return x * 2 }
+	expected := [][]int{{2, 0}, {4, 1}, {6, 2}}
+
+	seq := itertools.MapKeys(mapFunc, inSeq)
+	got := collectPairs(itertools.SliceUntil2(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
+func TestMapValues_earlyStop(t *testing.T) {
+	inSeq := itertools.Enumerate(itertools.RangeUntil(5, 1), 1)
+	takeLen := 3
+	mapFunc := func(x int) int { return x * 2 }
+	expected := [][]int{{1, 0}, {2, 2}, {3, 4}}
+
+	seq := itertools.MapValues(mapFunc, inSeq)
+	got := collectPairs(itertools.SliceUntil2(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
+func TestInvert_earlyStop(t *testing.T) {
+	inSeq := itertools.Enumerate(itertools.RangeUntil(5, 1), 1)
+	takeLen := 3
+	expected := [][]int{{0, 1}, {1, 2}, {2, 3}}
+
+	seq := itertools.Invert(inSeq)
+	got := collectPairs(itertools.SliceUntil2(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
 func testFilter[V any](t *testing.T, data []V, filterFunc func(V) bool, expected []V) {
 	t.Helper()
 	seq := itertools.Filter(filterFunc, slices.Values(data))
@@ -412,6 +463,57 @@ func TestValues_earlyStop(t *testing.T) {
 	require.Equal(t, expected, got)
 }
 
+func TestUniqueKeys_earlyStop(t *testing.T) {
+	inSeq := itertools.ZipPair(
+		slices.Values([]int{1, 1, 2, 1, 3, 4}),
+		itertools.RangeFrom(0, 1),
+	)
+	takeLen := 3
+	expected := [][]int{{1, 0}, {2, 2}, {3, 4}}
+
+	seq := itertools.UniqueKeys(inSeq)
+	got := collectPairs(itertools.SliceUntil2(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
+func TestUniqueValues_earlyStop(t *testing.T) {
+	inSeq := slices.All([]int{1, 1, 2, 1, 3, 4})
+	takeLen := 3
+	expected := [][]int{{0, 1}, {2, 2}, {4, 3}}
+
+	seq := itertools.UniqueValues(inSeq)
+	got := collectPairs(itertools.SliceUntil2(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
+func TestToPairs_earlyStop(t *testing.T) {
+	inSeq := itertools.Enumerate(itertools.RangeUntil(5, 1), 1)
+	takeLen := 2
+	expected := []tuple.Pair[int, int]{tuple.NewPair(1, 0), tuple.NewPair(2, 1)}
+
+	seq := itertools.ToPairs(inSeq)
+	got := slices.Collect(itertools.SliceUntil(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
+func TestFromPairs_earlyStop(t *testing.T) {
+	inSeq := slices.Values([]tuple.Pair[int, int]{
+		tuple.NewPair(1, 0),
+		tuple.NewPair(2, 1),
+		tuple.NewPair(3, 2),
+	})
+	takeLen := 2
+	expected := [][]int{{1, 0}, {2, 1}}
+
+	seq := itertools.FromPairs(inSeq)
+	got := collectPairs(itertools.SliceUntil2(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
 func TestEnumerate(t *testing.T) {
 	slice := []string{"foo", "bar", "wat", "baz"}
 	expectedIdx := []int{10, 11, 12, 13}
@@ -440,6 +542,17 @@ func TestEnumerate_earlyStop(t *testing.T) {
 	require.Equal(t, expectedVals, gotVals)
 }
 
+func TestKeyBy_earlyStop(t *testing.T) {
+	takeLen := 3
+	keyFunc := func(x int) int { return x * 2 }
+	expected := [][]int{{0, 0}, {2, 1}, {4, 2}}
+
+	seq := itertools.KeyBy(itertools.RangeUntil(5, 1), keyFunc)
+	got := collectPairs(itertools.SliceUntil2(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
 func TestAnyFunc(t *testing.T) {
 	data := []int{100, -1, 25, 13, 2, 4}
 
@@ -482,6 +595,155 @@ func TestAnyFunc(t *testing.T) {
 	}
 }
 
+func TestIndexFunc(t *testing.T) {
+	data := []int{100, -1, 25, 13, 2, 4}
+
+	for _, tc := range []struct {
+		desc     string
+		checker  func(int) bool
+		expected int
+	}{
+		{
+			"always true",
+			func(int) bool { return true },
+			0,
+		},
+		{
+			"never true",
+			func(int) bool { return false },
+			-1,
+		},
+		{
+			"negative",
+			func(i int) bool { return i < 0 },
+			1,
+		},
+		{
+			"last",
+			func(i int) bool { return i == 4 },
+			5,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := itertools.IndexFunc(tc.checker, slices.Values(data))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestLastFunc(t *testing.T) {
+	data := []int{100, -1, 25, 13, 2, 4}
+
+	for _, tc := range []struct {
+		desc          string
+		checker       func(int) bool
+		expected      int
+		expectedFound bool
+	}{
+		{
+			"always true",
+			func(int) bool { return true },
+			4,
+			true,
+		},
+		{
+			"never true",
+			func(int) bool { return false },
+			0,
+			false,
+		},
+		{
+			"odd",
+			func(i int) bool { return i%2 != 0 },
+			13,
+			true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, found := itertools.LastFunc(tc.checker, slices.Values(data))
+
+			require.Equal(t, tc.expected, got)
+			require.Equal(t, tc.expectedFound, found)
+		})
+	}
+}
+
+func TestLastIndexFunc(t *testing.T) {
+	data := []int{100, -1, 25, 13, 2, 4}
+
+	for _, tc := range []struct {
+		desc     string
+		checker  func(int) bool
+		expected int
+	}{
+		{
+			"always true",
+			func(int) bool { return true },
+			5,
+		},
+		{
+			"never true",
+			func(int) bool { return false },
+			-1,
+		},
+		{
+			"odd",
+			func(i int) bool { return i%2 != 0 },
+			3,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := itertools.LastIndexFunc(tc.checker, slices.Values(data))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestPositions(t *testing.T) {
+	data := []int{100, -1, 25, 13, 2, 4}
+
+	for _, tc := range []struct {
+		desc     string
+		checker  func(int) bool
+		expected []int
+	}{
+		{
+			"always true",
+			func(int) bool { return true },
+			[]int{0, 1, 2, 3, 4, 5},
+		},
+		{
+			"never true",
+			func(int) bool { return false },
+			nil,
+		},
+		{
+			"even",
+			func(i int) bool { return i%2 == 0 },
+			[]int{0, 4, 5},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := slices.Collect(itertools.Positions(tc.checker, slices.Values(data)))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestPositions_earlyStop(t *testing.T) {
+	baseSeq := itertools.RangeUntil(10, 1)
+	takeLen := 3
+	expected := []int{1, 3, 5}
+
+	seq := itertools.Positions(isOdd, baseSeq)
+	got := slices.Collect(itertools.SliceUntil(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
 func TestAllFunc(t *testing.T) {
 	data := []int{100, -1, 25, 13, 2, 4}
 
@@ -660,6 +922,49 @@ func TestRepeat_infinite(t *testing.T) {
 	require.Equal(t, expected, got)
 }
 
+func TestRepeatFunc(t *testing.T) {
+	counter := func() func() int {
+		count := 0
+		return func() int {
+			count++
+			return count
+		}
+	}
+
+	t.Run("finite", func(t *testing.T) {
+		got := slices.Collect(itertools.RepeatFunc(counter(), 3))
+
+		require.Equal(t, []int{1, 2, 3}, got)
+	})
+
+	t.Run("infinite", func(t *testing.T) {
+		seq := itertools.RepeatFunc(counter(), -1)
+		got := slices.Collect(itertools.SliceUntil(seq, 5, 1))
+
+		require.Equal(t, []int{1, 2, 3, 4, 5}, got)
+	})
+}
+
+func TestIterate_earlyExit(t *testing.T) {
+	takeLen := 5
+	expected := []int{1, 2, 4, 8, 16}
+
+	seq := itertools.Iterate(1, func(x int) int { return x * 2 })
+	got := slices.Collect(itertools.SliceUntil(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
+func TestUnfold_earlyExit(t *testing.T) {
+	takeLen := 3
+	expected := []int{0, 1, 2}
+
+	seq := itertools.Unfold(0, func(x int) (int, int, bool) { return x, x + 1, true })
+	got := slices.Collect(itertools.SliceUntil(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
 func TestAccumulate_earlyExit(t *testing.T) {
 	accumulator := func(x1 int, x2 int) int { return x1 * x2 }
 	baseSeq := itertools.Accumulate(itertools.Range(1, 10, 1), accumulator, 1)
@@ -801,6 +1106,97 @@ func TestIterCtx2_inputExhausted(t *testing.T) {
 	require.Equal(t, expected, got)
 }
 
+func TestIterCtxErr_inputExhausted(t *testing.T) {
+	seq, errFunc := itertools.IterCtxErr(context.Background(), itertools.RangeUntil(3, 1))
+
+	got := slices.Collect(seq)
+
+	require.Equal(t, []int{0, 1, 2}, got)
+	require.NoError(t, errFunc())
+}
+
+func TestIterCtxErr_earlyExit(t *testing.T) {
+	seq, errFunc := itertools.IterCtxErr(context.Background(), itertools.RangeUntil(10, 1))
+
+	got := slices.Collect(itertools.SliceUntil(seq, 3, 1))
+
+	require.Equal(t, []int{0, 1, 2}, got)
+	require.NoError(t, errFunc())
+}
+
+func TestIterCtxErr_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	seq, errFunc := itertools.IterCtxErr(ctx, itertools.RangeFrom(0, 1))
+
+	var got []int
+	for v := range seq {
+		got = append(got, v)
+		if v == 2 {
+			cancel()
+		}
+	}
+
+	// values already pulled may still be yielded after cancelling
+	require.Equal(t, []int{0, 1, 2}, got[:3])
+	require.ErrorIs(t, errFunc(), context.Canceled)
+}
+
+// blockedSeq returns a sequence that doesn't yield anything until unblock is
+// called, after which it yields 0 and 1. stopped is closed once it returns.
+func blockedSeq() (seq iter.Seq2[int, int], unblock func(), stopped <-chan struct{}) {
+	block := make(chan struct{})
+	done := make(chan struct{})
+	seq = func(yield func(int, int) bool) {
+		defer close(done)
+		<-block
+		for i := range 2 {
+			if !yield(i, i) {
+				return
+			}
+		}
+	}
+	return seq, func() { close(block) }, done
+}
+
+func TestIterCtx_cancelledWhileBlocked(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	seq, unblock, stopped := blockedSeq()
+
+	got := slices.Collect(itertools.IterCtx(ctx, itertools.Keys(seq)))
+
+	require.Empty(t, got)
+	unblock()
+	<-stopped
+}
+
+func TestIterCtx2_cancelledWhileBlocked(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	seq, unblock, stopped := blockedSeq()
+
+	got := collectPairs(itertools.IterCtx2(ctx, seq))
+
+	require.Empty(t, got)
+	unblock()
+	<-stopped
+}
+
+func TestIterCtxErr_deadlineExceeded(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	blocking, unblock, stopped := blockedSeq()
+	seq, errFunc := itertools.IterCtxErr(ctx, itertools.Keys(blocking))
+
+	got := slices.Collect(seq)
+
+	require.Empty(t, got)
+	require.ErrorIs(t, errFunc(), context.DeadlineExceeded)
+	unblock()
+	<-stopped
+}
+
 func TestPairwise_emptyIfFewerThanTwo(t *testing.T) {
 	for _, vals := range [][]int{{}, {1}} {
 		t.Run(fmt.Sprintf("%d", len(vals)), func(t *testing.T) {
@@ -809,3 +1205,1117 @@ func TestPairwise_emptyIfFewerThanTwo(t *testing.T) {
 		})
 	}
 }
+
+// singleUse returns a sequence over vals that continues from where it was
+// stopped when iterated over again, like a sequence backed by a reader.
+func singleUse[V any](vals []V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for len(vals) > 0 {
+			v := vals[0]
+			vals = vals[1:]
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func TestSplitAt(t *testing.T) {
+	for _, tc := range []struct {
+		n              int
+		expectedFirst  []int
+		expectedSecond []int
+	}{
+		{0, nil, []int{0, 1, 2, 3, 4}},
+		{2, []int{0, 1}, []int{2, 3, 4}},
+		{5, []int{0, 1, 2, 3, 4}, nil},
+		{10, []int{0, 1, 2, 3, 4}, nil},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			t.Run("in order", func(t *testing.T) {
+				first, second := itertools.SplitAt(singleUse([]int{0, 1, 2, 3, 4}), tc.n)
+
+				require.Equal(t, tc.expectedFirst, slices.Collect(first))
+				require.Equal(t, tc.expectedSecond, slices.Collect(second))
+			})
+
+			t.Run("second first", func(t *testing.T) {
+				first, second := itertools.SplitAt(singleUse([]int{0, 1, 2, 3, 4}), tc.n)
+
+				require.Equal(t, tc.expectedSecond, slices.Collect(second))
+				require.Equal(t, tc.expectedFirst, slices.Collect(first))
+			})
+		})
+	}
+}
+
+func TestSplitAt_panicsOnNegative(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"n for SplitAt must be non-negative",
+		func() { itertools.SplitAt(slices.Values([]int{}), -1) },
+	)
+}
+
+func TestSplitAt_earlyStop(t *testing.T) {
+	first, second := itertools.SplitAt(singleUse([]int{0, 1, 2, 3, 4, 5, 6}), 4)
+
+	require.Equal(t, []int{0, 1}, slices.Collect(itertools.SliceUntil(first, 2, 1)))
+	require.Equal(t, []int{4, 5}, slices.Collect(itertools.SliceUntil(second, 2, 1)))
+	require.Equal(t, []int{0, 1, 2, 3}, slices.Collect(first))
+}
+
+func TestSpan(t *testing.T) {
+	for _, tc := range []struct {
+		data           []int
+		expectedFirst  []int
+		expectedSecond []int
+	}{
+		{nil, nil, nil},
+		{[]int{5, 1, 2}, nil, []int{5, 1, 2}},
+		{[]int{1, 2, 5, 1, 2}, []int{1, 2}, []int{5, 1, 2}},
+		{[]int{1, 2, 3}, []int{1, 2, 3}, nil},
+	} {
+		predicate := func(x int) bool { return x < 5 }
+
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			t.Run("in order", func(t *testing.T) {
+				first, second := itertools.Span(singleUse(tc.data), predicate)
+
+				require.Equal(t, tc.expectedFirst, slices.Collect(first))
+				require.Equal(t, tc.expectedSecond, slices.Collect(second))
+			})
+
+			t.Run("second first", func(t *testing.T) {
+				first, second := itertools.Span(singleUse(tc.data), predicate)
+
+				require.Equal(t, tc.expectedSecond, slices.Collect(second))
+				require.Equal(t, tc.expectedFirst, slices.Collect(first))
+			})
+		})
+	}
+}
+
+func TestSpan_earlyStop(t *testing.T) {
+	predicate := func(x int) bool { return x < 5 }
+
+	t.Run("before boundary", func(t *testing.T) {
+		first, second := itertools.Span(singleUse([]int{0, 1, 2, 3, 5, 6, 7}), predicate)
+
+		require.Equal(t, []int{0, 1}, slices.Collect(itertools.SliceUntil(first, 2, 1)))
+		require.Equal(t, []int{5, 6}, slices.Collect(itertools.SliceUntil(second, 2, 1)))
+		require.Equal(t, []int{0, 1, 2, 3}, slices.Collect(first))
+	})
+
+	t.Run("at boundary", func(t *testing.T) {
+		_, second := itertools.Span(singleUse([]int{0, 5, 6}), predicate)
+
+		require.Equal(t, []int{5}, slices.Collect(itertools.SliceUntil(second, 1, 1)))
+	})
+}
+
+func TestEqual(t *testing.T) {
+	for _, tc := range []struct {
+		first    []int
+		second   []int
+		expected bool
+	}{
+		{nil, nil, true},
+		{[]int{1, 2, 3}, []int{1, 2, 3}, true},
+		{[]int{1, 2, 3}, []int{1, 2, 4}, false},
+		{[]int{1, 2}, []int{1, 2, 3}, false},
+		{[]int{1, 2, 3}, []int{1, 2}, false},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := itertools.Equal(slices.Values(tc.first), slices.Values(tc.second))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestEqual2(t *testing.T) {
+	for _, tc := range []struct {
+		first    []string
+		second   []string
+		expected bool
+	}{
+		{nil, nil, true},
+		{[]string{"a", "b"}, []string{"a", "b"}, true},
+		{[]string{"a", "b"}, []string{"a", "c"}, false},
+		{[]string{"a"}, []string{"a", "b"}, false},
+		{[]string{"a", "b"}, []string{"a"}, false},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := itertools.Equal2(slices.All(tc.first), slices.All(tc.second))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestEqual2_differentKeys(t *testing.T) {
+	first := slices.All([]string{"a", "b"})
+	second := itertools.Enumerate(slices.Values([]string{"a", "b"}), 1)
+
+	require.False(t, itertools.Equal2(first, second))
+}
+
+func TestCompare(t *testing.T) {
+	for _, tc := range []struct {
+		first    []int
+		second   []int
+		expected int
+	}{
+		{nil, nil, 0},
+		{[]int{1, 2, 3}, []int{1, 2, 3}, 0},
+		{[]int{1, 2, 3}, []int{1, 2, 4}, -1},
+		{[]int{1, 3}, []int{1, 2, 4}, 1},
+		{[]int{1, 2}, []int{1, 2, 3}, -1},
+		{[]int{1, 2, 3}, []int{1, 2}, 1},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := itertools.Compare(slices.Values(tc.first), slices.Values(tc.second))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestStartsWith(t *testing.T) {
+	for _, tc := range []struct {
+		data     []int
+		prefix   []int
+		expected bool
+	}{
+		{nil, nil, true},
+		{[]int{1, 2, 3}, nil, true},
+		{[]int{1, 2, 3}, []int{1, 2}, true},
+		{[]int{1, 2, 3}, []int{1, 2, 3}, true},
+		{[]int{1, 2, 3}, []int{1, 3}, false},
+		{[]int{1, 2}, []int{1, 2, 3}, false},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := itertools.StartsWith(slices.Values(tc.data), slices.Values(tc.prefix))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestStartsWith_infinite(t *testing.T) {
+	require.True(t, itertools.StartsWith(itertools.RangeFrom(0, 1), itertools.RangeUntil(5, 1)))
+}
+
+func TestEndsWith(t *testing.T) {
+	for _, tc := range []struct {
+		data     []int
+		suffix   []int
+		expected bool
+	}{
+		{nil, nil, true},
+		{[]int{1, 2, 3}, nil, true},
+		{[]int{1, 2, 3}, []int{2, 3}, true},
+		{[]int{1, 2, 3}, []int{1, 2, 3}, true},
+		{[]int{1, 2, 3, 4, 5}, []int{3, 4, 5}, true},
+		{[]int{1, 2, 3, 4, 5}, []int{3, 5}, false},
+		{[]int{2, 3}, []int{1, 2, 3}, false},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := itertools.EndsWith(slices.Values(tc.data), slices.Values(tc.suffix))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestAllEqual(t *testing.T) {
+	for _, tc := range []struct {
+		data     []int
+		expected bool
+	}{
+		{nil, true},
+		{[]int{1}, true},
+		{[]int{1, 1, 1}, true},
+		{[]int{1, 1, 2}, false},
+		{[]int{2, 1, 1}, false},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			require.Equal(t, tc.expected, itertools.AllEqual(slices.Values(tc.data)))
+		})
+	}
+}
+
+func TestAllEqual_infinite(t *testing.T) {
+	require.False(t, itertools.AllEqual(itertools.RangeFrom(0, 1)))
+}
+
+func TestAllUnique(t *testing.T) {
+	for _, tc := range []struct {
+		data     []int
+		expected bool
+	}{
+		{nil, true},
+		{[]int{1}, true},
+		{[]int{1, 2, 3}, true},
+		{[]int{1, 2, 1}, false},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			require.Equal(t, tc.expected, itertools.AllUnique(slices.Values(tc.data)))
+		})
+	}
+}
+
+func TestAllUnique_infinite(t *testing.T) {
+	require.False(t, itertools.AllUnique(itertools.Cycle(itertools.RangeUntil(3, 1))))
+}
+
+func TestPad_earlyStop(t *testing.T) {
+	takeLen := 5
+	expected := []int{0, 1, 2, -1, -1}
+
+	seq := itertools.Pad(itertools.RangeUntil(3, 1), -1)
+	got := slices.Collect(itertools.SliceUntil(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
+func TestPadTo(t *testing.T) {
+	for _, tc := range []struct {
+		data     []int
+		n        int
+		expected []int
+	}{
+		{nil, 0, nil},
+		{nil, 2, []int{-1, -1}},
+		{[]int{1, 2}, 4, []int{1, 2, -1, -1}},
+		{[]int{1, 2, 3, 4}, 4, []int{1, 2, 3, 4}},
+		{[]int{1, 2, 3, 4, 5}, 4, []int{1, 2, 3, 4}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := slices.Collect(itertools.PadTo(slices.Values(tc.data), -1, tc.n))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestPadTo_panicsOnNegative(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"n for PadTo must be non-negative",
+		func() { itertools.PadTo(slices.Values([]int{}), 0, -1) },
+	)
+}
+
+func TestReplace(t *testing.T) {
+	data := []int{1, -2, 3, -4, -5}
+	predicate := func(x int) bool { return x < 0 }
+
+	for _, tc := range []struct {
+		limit    int
+		expected []int
+	}{
+		{-1, []int{1, 0, 3, 0, 0}},
+		{0, []int{1, -2, 3, -4, -5}},
+		{2, []int{1, 0, 3, 0, -5}},
+		{10, []int{1, 0, 3, 0, 0}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := slices.Collect(itertools.Replace(slices.Values(data), predicate, 0, tc.limit))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestReplace_earlyStop(t *testing.T) {
+	takeLen := 3
+	expected := []int{0, 1, 0}
+
+	seq := itertools.Replace(itertools.RangeUntil(10, 1), isEven, 0, -1)
+	got := slices.Collect(itertools.SliceUntil(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
+func TestRotate(t *testing.T) {
+	data := []int{1, 2, 3, 4}
+
+	for _, tc := range []struct {
+		data     []int
+		n        int
+		expected []int
+	}{
+		{nil, 1, nil},
+		{nil, -1, nil},
+		{data, 0, []int{1, 2, 3, 4}},
+		{data, 1, []int{2, 3, 4, 1}},
+		{data, 3, []int{4, 1, 2, 3}},
+		{data, 4, []int{1, 2, 3, 4}},
+		{data, 6, []int{3, 4, 1, 2}},
+		{data, -1, []int{4, 1, 2, 3}},
+		{data, -4, []int{1, 2, 3, 4}},
+		{data, -6, []int{3, 4, 1, 2}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := slices.Collect(itertools.Rotate(slices.Values(tc.data), tc.n))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestRotate_earlyStop(t *testing.T) {
+	for _, tc := range []struct {
+		n        int
+		takeLen  int
+		expected []int
+	}{
+		{2, 1, []int{2}},
+		{2, 4, []int{2, 3, 4, 0}},
+		{-1, 2, []int{4, 0}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			seq := itertools.Rotate(itertools.RangeUntil(5, 1), tc.n)
+			got := slices.Collect(itertools.SliceUntil(seq, tc.takeLen, 1))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestReversed_earlyStop(t *testing.T) {
+	takeLen := 3
+	expected := []int{9, 8, 7}
+
+	seq := itertools.Reversed(itertools.RangeUntil(10, 1))
+	got := slices.Collect(itertools.SliceUntil(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
+func TestReversedSlices(t *testing.T) {
+	for _, tc := range []struct {
+		sls      [][]int
+		expected []int
+	}{
+		{nil, nil},
+		{[][]int{{}, {}}, nil},
+		{[][]int{{1, 2}, {}, {3}}, []int{3, 2, 1}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := slices.Collect(itertools.ReversedSlices(tc.sls...))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestReversedSlices_earlyStop(t *testing.T) {
+	takeLen := 3
+	expected := []int{5, 4, 3}
+
+	seq := itertools.ReversedSlices([]int{1, 2, 3}, []int{4, 5})
+	got := slices.Collect(itertools.SliceUntil(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
+func TestSorted_lazy(t *testing.T) {
+	data := []int{3, 1, 2}
+	seq := itertools.Sorted(slices.Values(data), cmp.Compare)
+	data[0] = 0
+
+	require.Equal(t, []int{0, 1, 2}, slices.Collect(seq))
+}
+
+func TestSorted_earlyStop(t *testing.T) {
+	takeLen := 3
+	expected := []int{0, 1, 2}
+
+	seq := itertools.Sorted(itertools.Range(9, -1, -1), cmp.Compare)
+	got := slices.Collect(itertools.SliceUntil(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
+func TestRunLengthEncode(t *testing.T) {
+	for _, tc := range []struct {
+		data     string
+		expected [][]any
+	}{
+		{"", nil},
+		{"a", [][]any{{'a', 1}}},
+		{"aaabccd", [][]any{{'a', 3}, {'b', 1}, {'c', 2}, {'d', 1}}},
+	} {
+		t.Run(tc.data, func(t *testing.T) {
+			var got [][]any
+			for r, n := range itertools.RunLengthEncode(slices.Values([]rune(tc.data))) {
+				got = append(got, []any{r, n})
+			}
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestRunLengthEncode_earlyStop(t *testing.T) {
+	data := slices.Values([]int{1, 1, 2, 3, 3, 3})
+	takeLen := 2
+	expected := [][]int{{1, 2}, {2, 1}}
+
+	seq := itertools.RunLengthEncode(data)
+	got := collectPairs(itertools.SliceUntil2(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
+func TestRunLengthDecode_earlyStop(t *testing.T) {
+	data := itertools.ZipPair(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}))
+	takeLen := 3
+	expected := []int{1, 1, 2}
+
+	seq := itertools.RunLengthDecode(data)
+	got := slices.Collect(itertools.SliceUntil(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
+func TestRunLengthDecode_nonComparable(t *testing.T) {
+	// built by hand since RunLengthEncode needs comparable values
+	data := func(yield func([]int, int) bool) {
+		if yield([]int{1}, 2) {
+			yield([]int{2, 3}, 1)
+		}
+	}
+
+	got := slices.Collect(itertools.RunLengthDecode(data))
+
+	require.Equal(t, [][]int{{1}, {1}, {2, 3}}, got)
+}
+
+func TestDiffs(t *testing.T) {
+	sub := func(x int, y int) int { return x - y }
+
+	for _, tc := range []struct {
+		data     []int
+		expected []int
+	}{
+		{nil, nil},
+		{[]int{1}, nil},
+		{[]int{1, 3, 6, 10}, []int{2, 3, 4}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := slices.Collect(itertools.Diffs(slices.Values(tc.data), sub))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestDiffs_earlyStop(t *testing.T) {
+	sub := func(x int, y int) int { return x - y }
+	takeLen := 3
+	expected := []int{2, 2, 2}
+
+	seq := itertools.Diffs(itertools.RangeFrom(0, 2), sub)
+	got := slices.Collect(itertools.SliceUntil(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
+func TestWindowReduce(t *testing.T) {
+	concat := func(acc string, s string) string { return acc + s }
+
+	for _, tc := range []struct {
+		data     []string
+		n        int
+		expected []string
+	}{
+		{nil, 1, nil},
+		{[]string{"a", "b"}, 3, nil},
+		{[]string{"a", "b", "c"}, 1, []string{"a", "b", "c"}},
+		{[]string{"a", "b", "c", "d"}, 2, []string{"ab", "bc", "cd"}},
+		{[]string{"a", "b", "c", "d"}, 4, []string{"abcd"}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			seq := itertools.WindowReduce(slices.Values(tc.data), tc.n, concat, "")
+
+			require.Equal(t, tc.expected, slices.Collect(seq))
+		})
+	}
+}
+
+func TestWindowReduce_earlyStop(t *testing.T) {
+	add := func(x int, y int) int { return x + y }
+	takeLen := 3
+	expected := []int{3, 6, 9}
+
+	seq := itertools.WindowReduce(itertools.RangeFrom(0, 1), 3, add, 0)
+	got := slices.Collect(itertools.SliceUntil(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
+func TestWindowReduce_panicsOnBadN(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"n for WindowReduce must be a positive integer",
+		func() {
+			itertools.WindowReduce(slices.Values([]int{}), 0, func(int, int) int { return 0 }, 0)
+		},
+	)
+}
+
+func TestMovingAverage(t *testing.T) {
+	for _, tc := range []struct {
+		data     []int
+		n        int
+		expected []float64
+	}{
+		{nil, 1, nil},
+		{[]int{1, 2}, 3, nil},
+		{[]int{1, 2, 3}, 1, []float64{1, 2, 3}},
+		{[]int{1, 2, 3, 6}, 2, []float64{1.5, 2.5, 4.5}},
+		{[]int{1, 2, 3, 6}, 4, []float64{3}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			seq := itertools.MovingAverage(slices.Values(tc.data), tc.n)
+
+			require.Equal(t, tc.expected, slices.Collect(seq))
+		})
+	}
+}
+
+func TestMovingAverage_smallIntegers(t *testing.T) {
+	require.Equal(
+		t,
+		[]float64{200, 200},
+		slices.Collect(itertools.MovingAverage(itertools.SeqOf[uint8](200, 200, 200), 2)),
+	)
+	require.Equal(
+		t,
+		[]float64{100},
+		slices.Collect(itertools.MovingAverage(itertools.SeqOf[int8](100, 100), 2)),
+	)
+}
+
+func TestMovingAverage_largeFloats(t *testing.T) {
+	require.Equal(
+		t,
+		[]float64{1e17, 1, 1, 1},
+		slices.Collect(itertools.MovingAverage(itertools.SeqOf(1e17, 1, 1, 1), 1)),
+	)
+	require.Equal(
+		t,
+		[]float64{5e16, 1, 1},
+		slices.Collect(itertools.MovingAverage(itertools.SeqOf(1e17, 1, 1, 1), 2)),
+	)
+	require.Equal(
+		t,
+		[]float64{5e16, 5e16, 1, 1},
+		slices.Collect(itertools.MovingAverage(itertools.SeqOf(1, 1e17, 1, 1, 1), 2)),
+	)
+}
+
+func TestMovingAverage_earlyStop(t *testing.T) {
+	takeLen := 3
+	expected := []float64{1, 2, 3}
+
+	seq := itertools.MovingAverage(itertools.RangeFrom(0, 1), 3)
+	got := slices.Collect(itertools.SliceUntil(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
+func TestMovingAverage_panicsOnBadN(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"n for MovingAverage must be a positive integer",
+		func() { itertools.MovingAverage(slices.Values([]int{}), -1) },
+	)
+}
+
+func TestCollapse(t *testing.T) {
+	data := []any{
+		1,
+		[]int{2, 3},
+		[...]any{4, []any{5, []int{6}}},
+		"seven",
+		map[int]int{8: 8},
+		nil,
+	}
+
+	for _, tc := range []struct {
+		maxDepth int
+		expected []any
+	}{
+		{
+			0,
+			data,
+		},
+		{
+			1,
+			[]any{1, 2, 3, 4, []any{5, []int{6}}, "seven", map[int]int{8: 8}, nil},
+		},
+		{
+			-1,
+			[]any{1, 2, 3, 4, 5, 6, "seven", map[int]int{8: 8}, nil},
+		},
+	} {
+		t.Run(fmt.Sprintf("%d", tc.maxDepth), func(t *testing.T) {
+			got := slices.Collect(itertools.Collapse(slices.Values(data), tc.maxDepth))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestCollapse_bytes(t *testing.T) {
+	type bytes []byte
+	data := []any{[]byte("xy"), []any{bytes("z")}, [...]byte{1, 2}}
+
+	got := slices.Collect(itertools.Collapse(slices.Values(data), -1))
+
+	require.Equal(t, []any{[]byte("xy"), bytes("z"), [...]byte{1, 2}}, got)
+}
+
+func TestCollapse_funcs(t *testing.T) {
+	var nilSeq iter.Seq[int]
+	notSeq := func() int { return 1 }
+	data := []any{slices.Values([]any{1, []int{2}}), nilSeq, notSeq}
+
+	got := slices.Collect(itertools.Collapse(slices.Values(data), -1))
+
+	// func values can't be compared, so check the types
+	require.Len(t, got, 3)
+	require.Equal(t, []any{1, 2}, got[:2])
+	require.IsType(t, notSeq, got[2])
+}
+
+func TestCollapse_earlyStop(t *testing.T) {
+	data := slices.Values([]any{[]any{1, []int{2, 3}}, slices.Values([]int{4, 5}), 6})
+
+	for _, takeLen := range []int{1, 2, 4} {
+		t.Run(strconv.Itoa(takeLen), func(t *testing.T) {
+			seq := itertools.Collapse(data, -1)
+			got := slices.Collect(itertools.SliceUntil(seq, takeLen, 1))
+
+			require.Equal(t, []any{1, 2, 3, 4, 5, 6}[:takeLen], got)
+		})
+	}
+}
+
+func TestSortedByKey_stable(t *testing.T) {
+	seq := itertools.ZipPair(
+		slices.Values([]string{"b", "a", "b", "a"}),
+		slices.Values([]int{1, 2, 3, 4}),
+	)
+	expectedKeys := []string{"a", "a", "b", "b"}
+	expectedValues := []int{2, 4, 1, 3}
+
+	sorted := itertools.SortedByKey(seq)
+
+	require.Equal(t, expectedKeys, slices.Collect(itertools.Keys(sorted)))
+	require.Equal(t, expectedValues, slices.Collect(itertools.Values(sorted)))
+}
+
+func TestSortedByValue_earlyStop(t *testing.T) {
+	seq := slices.All([]int{3, 1, 2, 0})
+	takeLen := 2
+	expected := [][]int{{3, 0}, {1, 1}}
+
+	got := collectPairs(itertools.SliceUntil2(itertools.SortedByValue(seq), takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
+func collectJoin[K comparable, L any, R any](seq iter.Seq2[K, tuple.Pair[L, R]]) [][]any {
+	var res [][]any //nolint:prealloc
+	for k, p := range seq {
+		res = append(res, []any{k, p.First, p.Second})
+	}
+	return res
+}
+
+func TestJoin(t *testing.T) {
+	left := itertools.ZipPair(
+		slices.Values([]int{1, 2, 3, 1}),
+		slices.Values([]string{"a", "b", "c", "d"}),
+	)
+	right := itertools.ZipPair(
+		slices.Values([]int{1, 3, 1, 4}),
+		slices.Values([]bool{true, false, false, true}),
+	)
+	expected := [][]any{
+		{1, "a", true},
+		{1, "a", false},
+		{3, "c", false},
+		{1, "d", true},
+		{1, "d", false},
+	}
+
+	got := collectJoin(itertools.Join(left, right))
+
+	require.Equal(t, expected, got)
+}
+
+func TestJoin_earlyStop(t *testing.T) {
+	left := slices.All([]string{"a", "b", "c"})
+	right := slices.All([]string{"x", "y", "z"})
+	takeLen := 2
+	expected := [][]any{{0, "a", "x"}, {1, "b", "y"}}
+
+	seq := itertools.SliceUntil2(itertools.Join(left, right), takeLen, 1)
+
+	require.Equal(t, expected, collectJoin(seq))
+}
+
+func TestLeftJoin(t *testing.T) {
+	left := itertools.ZipPair(
+		slices.Values([]int{1, 2, 3}),
+		slices.Values([]string{"a", "b", "c"}),
+	)
+	right := itertools.ZipPair(
+		slices.Values([]int{1, 3, 1, 4}),
+		slices.Values([]string{"w", "x", "y", "z"}),
+	)
+	expected := [][]any{
+		{1, "a", "w"},
+		{1, "a", "y"},
+		{2, "b", "-"},
+		{3, "c", "x"},
+	}
+
+	got := collectJoin(itertools.LeftJoin(left, right, "-"))
+
+	require.Equal(t, expected, got)
+}
+
+func TestLeftJoin_earlyStop(t *testing.T) {
+	left := slices.All([]string{"a", "b", "c"})
+	right := slices.All([]string{"x"})
+	takeLen := 2
+	expected := [][]any{{0, "a", "x"}, {1, "b", ""}}
+
+	seq := itertools.SliceUntil2(itertools.LeftJoin(left, right, ""), takeLen, 1)
+
+	require.Equal(t, expected, collectJoin(seq))
+}
+
+func TestOuterJoin(t *testing.T) {
+	left := itertools.ZipPair(
+		slices.Values([]int{1, 2, 3}),
+		slices.Values([]string{"a", "b", "c"}),
+	)
+	right := itertools.ZipPair(
+		slices.Values([]int{5, 1, 3, 4, 5}),
+		slices.Values([]string{"v", "w", "x", "y", "z"}),
+	)
+	expected := [][]any{
+		{1, "a", "w"},
+		{2, "b", "-"},
+		{3, "c", "x"},
+		{5, "+", "v"},
+		{5, "+", "z"},
+		{4, "+", "y"},
+	}
+
+	got := collectJoin(itertools.OuterJoin(left, right, "+", "-"))
+
+	require.Equal(t, expected, got)
+}
+
+func TestOuterJoin_earlyStop(t *testing.T) {
+	left := slices.All([]string{"a", "b"})
+	right := slices.All([]string{"x", "y", "z", "zz"})
+
+	for _, tc := range []struct {
+		takeLen  int
+		expected [][]any
+	}{
+		{1, [][]any{{0, "a", "x"}}},
+		{3, [][]any{{0, "a", "x"}, {1, "b", "y"}, {2, "", "z"}}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			seq := itertools.SliceUntil2(itertools.OuterJoin(left, right, "", ""), tc.takeLen, 1)
+
+			require.Equal(t, tc.expected, collectJoin(seq))
+		})
+	}
+}
+
+func TestCrossJoin(t *testing.T) {
+	for _, tc := range []struct {
+		first    []int
+		second   []int
+		expected [][]int
+	}{
+		{nil, []int{1}, nil},
+		{[]int{1}, nil, nil},
+		{[]int{1, 2}, []int{3, 4}, [][]int{{1, 3}, {1, 4}, {2, 3}, {2, 4}}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			seq := itertools.CrossJoin(slices.Values(tc.first), singleUse(tc.second))
+
+			require.Equal(t, tc.expected, collectPairs(seq))
+		})
+	}
+}
+
+func TestCrossJoin_earlyStop(t *testing.T) {
+	for _, tc := range []struct {
+		takeLen  int
+		expected [][]int
+	}{
+		{1, [][]int{{1, 3}}},
+		{3, [][]int{{1, 3}, {1, 4}, {2, 3}}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			seq := itertools.CrossJoin(slices.Values([]int{1, 2}), slices.Values([]int{3, 4}))
+
+			got := collectPairs(itertools.SliceUntil2(seq, tc.takeLen, 1))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func applyEdits[V comparable](t *testing.T, a []V, edits []itertools.Edit[V]) []V {
+	t.Helper()
+
+	var res []V
+	for _, e := range edits {
+		switch e.Op {
+		case itertools.EditKeep:
+			require.Equal(t, a[0], e.Value)
+			res = append(res, a[0])
+			a = a[1:]
+		case itertools.EditDelete:
+			require.Equal(t, a[0], e.Value)
+			a = a[1:]
+		case itertools.EditInsert:
+			res = append(res, e.Value)
+		}
+	}
+	require.Empty(t, a)
+	return res
+}
+
+func TestDiff(t *testing.T) {
+	for _, tc := range []struct {
+		first        string
+		second       string
+		expectedDist int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"", "abc", 3},
+		{"abc", "abc", 0},
+		{"abcabba", "cbabac", 5},
+		{"kitten", "sitting", 5},
+		{"abcdef", "xyz", 9},
+	} {
+		t.Run(tc.first+"->"+tc.second, func(t *testing.T) {
+			a := []rune(tc.first)
+			b := []rune(tc.second)
+
+			edits := slices.Collect(itertools.Diff(slices.Values(a), slices.Values(b)))
+
+			var dist int
+			for _, e := range edits {
+				if e.Op != itertools.EditKeep {
+					dist++
+				}
+			}
+			require.Equal(t, tc.expectedDist, dist)
+			require.Equal(t, tc.second, string(applyEdits(t, a, edits)))
+		})
+	}
+}
+
+func TestDiff_earlyStop(t *testing.T) {
+	takeLen := 2
+	expected := []itertools.Edit[int]{
+		{Op: itertools.EditKeep, Value: 1},
+		{Op: itertools.EditDelete, Value: 2},
+	}
+
+	seq := itertools.Diff(slices.Values([]int{1, 2, 3}), slices.Values([]int{1, 3, 4}))
+	got := slices.Collect(itertools.SliceUntil(seq, takeLen, 1))
+
+	require.Equal(t, expected, got)
+}
+
+func TestEditOp_String(t *testing.T) {
+	for op, expected := range map[itertools.EditOp]string{
+		itertools.EditKeep:   "keep",
+		itertools.EditDelete: "delete",
+		itertools.EditInsert: "insert",
+		itertools.EditOp(10): "EditOp(10)",
+	} {
+		require.Equal(t, expected, op.String())
+	}
+}
+
+func isSubsequence(sub []rune, s []rune) bool {
+	for _, r := range s {
+		if len(sub) > 0 && sub[0] == r {
+			sub = sub[1:]
+		}
+	}
+	return len(sub) == 0
+}
+
+func TestLCS(t *testing.T) {
+	for _, tc := range []struct {
+		first       string
+		second      string
+		expectedLen int
+	}{
+		{"", "abc", 0},
+		{"abc", "def", 0},
+		{"abc", "abc", 3},
+		{"abcbdab", "bdcaba", 4},
+		{"kitten", "sitting", 4},
+	} {
+		t.Run(tc.first+","+tc.second, func(t *testing.T) {
+			a := []rune(tc.first)
+			b := []rune(tc.second)
+
+			got := itertools.LCS(slices.Values(a), slices.Values(b))
+
+			require.Len(t, got, tc.expectedLen)
+			require.True(t, isSubsequence(got, a))
+			require.True(t, isSubsequence(got, b))
+		})
+	}
+}
+
+func TestRangeOf(t *testing.T) {
+	t.Run("int8 full range", func(t *testing.T) {
+		got := slices.Collect(itertools.RangeOf[int8](-128, 127, 1))
+
+		require.Len(t, got, 255)
+		require.Equal(t, int8(-128), got[0])
+		require.Equal(t, int8(126), got[len(got)-1])
+	})
+
+	t.Run("int8 min step", func(t *testing.T) {
+		got := slices.Collect(itertools.RangeOf[int8](127, -128, -128))
+
+		require.Equal(t, []int8{127, -1}, got)
+	})
+
+	t.Run("uint8 near max", func(t *testing.T) {
+		got := slices.Collect(itertools.RangeOf[uint8](250, 255, 2))
+
+		require.Equal(t, []uint8{250, 252, 254}, got)
+	})
+
+	t.Run("int64 extremes", func(t *testing.T) {
+		got := slices.Collect(itertools.RangeOf[int64](math.MinInt64, math.MaxInt64, math.MaxInt64))
+
+		require.Equal(t, []int64{math.MinInt64, -1, math.MaxInt64 - 1}, got)
+	})
+
+	t.Run("uint32 until", func(t *testing.T) {
+		got := slices.Collect(itertools.RangeUntilOf[uint32](10, 4))
+
+		require.Equal(t, []uint32{0, 4, 8}, got)
+	})
+
+	t.Run("int16 from", func(t *testing.T) {
+		got := slices.Collect(itertools.SliceUntil(itertools.RangeFromOf[int16](-2, 3), 3, 1))
+
+		require.Equal(t, []int16{-2, 1, 4}, got)
+	})
+}
+
+func TestJoinString(t *testing.T) {
+	for _, tc := range []struct {
+		vals []string
+		sep  string
+	}{
+		{nil, ","},
+		{[]string{""}, ","},
+		{[]string{"a"}, ","},
+		{[]string{"a", "b", "c"}, ""},
+		{[]string{"a", "", "c"}, ", "},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := itertools.JoinString(slices.Values(tc.vals), tc.sep)
+
+			require.Equal(t, strings.Join(tc.vals, tc.sep), got)
+		})
+	}
+}
+
+var errHash = errors.New("hash error")
+
+// failingHash is a hash.Hash that fails to write
+type failingHash struct{ hash.Hash }
+
+func (failingHash) Write([]byte) (int, error) { return 0, errHash }
+
+func TestHashSeq(t *testing.T) {
+	for _, tc := range []struct {
+		vals []string
+	}{
+		{nil},
+		{[]string{""}},
+		{[]string{"hello"}},
+		{[]string{"hel", "", "lo"}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			seq := itertools.Map(func(s string) []byte { return []byte(s) }, slices.Values(tc.vals))
+			expected := sha256.Sum256([]byte(strings.Join(tc.vals, "")))
+
+			got, err := itertools.HashSeq(sha256.New(), seq)
+
+			require.NoError(t, err)
+			require.Equal(t, expected[:], got)
+		})
+	}
+}
+
+func TestHashSeq_writeError(t *testing.T) {
+	got, err := itertools.HashSeq(failingHash{sha256.New()}, slices.Values([][]byte{{1}}))
+
+	require.ErrorIs(t, err, errHash)
+	require.Nil(t, got)
+}
+
+func TestHashSeqFunc(t *testing.T) {
+	expected := sha256.Sum256([]byte("123"))
+
+	got, err := itertools.HashSeqFunc(
+		sha256.New(),
+		slices.Values([]int{1, 2, 3}),
+		func(n int) ([]byte, error) { return []byte(strconv.Itoa(n)), nil },
+	)
+
+	require.NoError(t, err)
+	require.Equal(t, expected[:], got)
+}
+
+func TestHashSeqFunc_encodeError(t *testing.T) {
+	var encoded []int
+
+	got, err := itertools.HashSeqFunc(
+		sha256.New(),
+		slices.Values([]int{1, 2, 3}),
+		func(n int) ([]byte, error) {
+			encoded = append(encoded, n)
+			if n == 2 {
+				return nil, errHash
+			}
+			return []byte{byte(n)}, nil
+		},
+	)
+
+	require.ErrorIs(t, err, errHash)
+	require.Nil(t, got)
+	require.Equal(t, []int{1, 2}, encoded)
+}
@@ -0,0 +1,150 @@
+package itertools
+
+import (
+	"iter"
+	"math"
+)
+
+// Fibonacci returns a [iter.Seq] that yields the Fibonacci numbers: 0, 1, 1,
+// 2, 3, 5 and so on, indefinitely.
+//
+// The values are computed with int arithmetic, so they overflow after the
+// 92nd Fibonacci number on 64-bit platforms.
+func Fibonacci() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for a, b := 0, 1; ; a, b = b, a+b {
+			if !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// Factorials returns a [iter.Seq] that yields the factorials of 0, 1, 2 and
+// so on, i.e. 1, 1, 2, 6, 24 and so on, indefinitely.
+//
+// The values are computed with int arithmetic, so they overflow after 20! on
+// 64-bit platforms.
+func Factorials() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for n, f := 1, 1; ; n, f = n+1, f*n {
+			if !yield(f) {
+				return
+			}
+		}
+	}
+}
+
+// Triangular returns a [iter.Seq] that yields the triangular numbers, i.e.
+// the sums of the first n positive integers for n = 0, 1, 2 and so on: 0, 1,
+// 3, 6, 10 and so on, indefinitely.
+func Triangular() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for n, t := 1, 0; ; n, t = n+1, t+n {
+			if !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// Primes returns a [iter.Seq] that yields the prime numbers in increasing
+// order, indefinitely.
+//
+// It uses an incremental sieve of Eratosthenes, so the memory used is
+// proportional to the number of primes yielded so far, rather than their
+// size.
+func Primes() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		if !yield(2) {
+			return
+		}
+
+		// maps each upcoming odd composite number to the step between odd
+		// multiples (i.e. twice) of one of its prime factors
+		composites := map[int]int{}
+		for n := 3; ; n += 2 {
+			step, ok := composites[n]
+			if !ok {
+				if !yield(n) {
+					return
+				}
+				composites[n*n] = 2 * n
+				continue
+			}
+
+			delete(composites, n)
+			next := n + step
+			for {
+				if _, ok := composites[next]; !ok {
+					break
+				}
+				next += step
+			}
+			composites[next] = step
+		}
+	}
+}
+
+// Linspace returns a [iter.Seq] that yields n evenly spaced values from start
+// to stop, inclusive. Each value is computed from its index, rather than by
+// repeatedly adding a step, so rounding errors don't accumulate, and the last
+// value is exactly stop. As with NumPy's linspace, if n is 1 the only value
+// is start.
+//
+// Linspace panics if n is negative.
+func Linspace(start float64, stop float64, n int) iter.Seq[float64] {
+	if n < 0 {
+		panic("n for Linspace must be non-negative")
+	}
+	return func(yield func(float64) bool) {
+		for i := range n {
+			v := start
+			switch {
+			case i == n-1 && n > 1:
+				v = stop
+			case i > 0:
+				v = start + (stop-start)*float64(i)/float64(n-1)
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Logspace returns a [iter.Seq] that yields n values spaced evenly on a log
+// scale from base**start to base**stop, inclusive. I.e. it yields base raised
+// to the power of each value yielded by [Linspace].
+//
+// Logspace panics if n is negative.
+func Logspace(start float64, stop float64, n int, base float64) iter.Seq[float64] {
+	if n < 0 {
+		panic("n for Logspace must be non-negative")
+	}
+	return Map(func(x float64) float64 { return math.Pow(base, x) }, Linspace(start, stop, n))
+}
+
+// RangeFloat is like [Range] but for float64 values. Each value is computed
+// as start + i*step, rather than by repeatedly adding step, so rounding errors
+// don't accumulate. Iteration stops at the first value that reaches end, so
+// end is never yielded even if rounding puts a computed value exactly on it.
+//
+// RangeFloat panics if step is 0.
+func RangeFloat(start float64, end float64, step float64) iter.Seq[float64] {
+	if step == 0 {
+		panic("step for RangeFloat must be non-zero")
+	}
+	return func(yield func(float64) bool) {
+		for i := 0.0; ; i++ {
+			v := start + i*step
+			// negated so that a NaN end yields nothing
+			if step > 0 && !(v < end) || step < 0 && !(v > end) {
+				return
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
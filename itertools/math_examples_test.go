@@ -0,0 +1,107 @@
+package itertools_test
+
+import (
+	"fmt"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+)
+
+func ExampleFibonacci() {
+	even := itertools.Filter(isEven, itertools.Fibonacci())
+
+	for n := range itertools.SliceUntil(even, 5, 1) {
+		fmt.Println(n)
+	}
+
+	// output:
+	// 0
+	// 2
+	// 8
+	// 34
+	// 144
+}
+
+func ExampleFactorials() {
+	for i, n := range itertools.Enumerate(itertools.SliceUntil(itertools.Factorials(), 5, 1), 0) {
+		fmt.Printf("%d! = %d\n", i, n)
+	}
+
+	// output:
+	// 0! = 1
+	// 1! = 1
+	// 2! = 2
+	// 3! = 6
+	// 4! = 24
+}
+
+func ExampleTriangular() {
+	small := itertools.TakeWhile(itertools.Triangular(), func(n int) bool { return n < 20 })
+
+	for n := range small {
+		fmt.Println(n)
+	}
+
+	// output:
+	// 0
+	// 1
+	// 3
+	// 6
+	// 10
+	// 15
+}
+
+func ExamplePrimes() {
+	for p := range itertools.SliceUntil(itertools.Primes(), 10, 1) {
+		fmt.Println(p)
+	}
+
+	// output:
+	// 2
+	// 3
+	// 5
+	// 7
+	// 11
+	// 13
+	// 17
+	// 19
+	// 23
+	// 29
+}
+
+func ExampleLinspace() {
+	for x := range itertools.Linspace(0, 1, 5) {
+		fmt.Println(x)
+	}
+
+	// output:
+	// 0
+	// 0.25
+	// 0.5
+	// 0.75
+	// 1
+}
+
+func ExampleLogspace() {
+	for x := range itertools.Logspace(0, 3, 4, 10) {
+		fmt.Println(x)
+	}
+
+	// output:
+	// 1
+	// 10
+	// 100
+	// 1000
+}
+
+func ExampleRangeFloat() {
+	for x := range itertools.RangeFloat(0, 0.5, 0.1) {
+		fmt.Println(x)
+	}
+
+	// output:
+	// 0
+	// 0.1
+	// 0.2
+	// 0.30000000000000004
+	// 0.4
+}
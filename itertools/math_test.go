@@ -0,0 +1,155 @@
+package itertools_test
+
+import (
+	"fmt"
+	"iter"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+)
+
+func TestIntegerSequences(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		seq      iter.Seq[int]
+		expected []int
+	}{
+		{"Fibonacci", itertools.Fibonacci(), []int{0, 1, 1, 2, 3, 5, 8, 13}},
+		{"Factorials", itertools.Factorials(), []int{1, 1, 2, 6, 24, 120, 720, 5040}},
+		{"Triangular", itertools.Triangular(), []int{0, 1, 3, 6, 10, 15, 21, 28}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := slices.Collect(itertools.SliceUntil(tc.seq, len(tc.expected), 1))
+			again := slices.Collect(itertools.SliceUntil(tc.seq, len(tc.expected), 1))
+
+			require.Equal(t, tc.expected, got)
+			require.Equal(t, tc.expected, again)
+		})
+	}
+}
+
+func TestPrimes(t *testing.T) {
+	isPrime := func(n int) bool {
+		for d := 2; d*d <= n; d++ {
+			if n%d == 0 {
+				return false
+			}
+		}
+		return n > 1
+	}
+	limit := 10_000
+	expected := slices.Collect(itertools.Filter(isPrime, itertools.RangeUntil(limit, 1)))
+
+	seq := itertools.TakeWhile(itertools.Primes(), func(n int) bool { return n < limit })
+	got := slices.Collect(seq)
+
+	require.Equal(t, expected, got)
+}
+
+func TestPrimes_earlyStop(t *testing.T) {
+	got := slices.Collect(itertools.SliceUntil(itertools.Primes(), 1, 1))
+
+	require.Equal(t, []int{2}, got)
+}
+
+func TestLinspace(t *testing.T) {
+	for _, tc := range []struct {
+		start    float64
+		stop     float64
+		n        int
+		expected []float64
+	}{
+		{0, 1, 0, nil},
+		{0, 1, 1, []float64{0}},
+		{0, 1, 2, []float64{0, 1}},
+		{0, 1, 5, []float64{0, 0.25, 0.5, 0.75, 1}},
+		{1, -1, 3, []float64{1, 0, -1}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := slices.Collect(itertools.Linspace(tc.start, tc.stop, tc.n))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestLinspace_exactEnd(t *testing.T) {
+	n := 1000
+	stop := 0.3
+
+	got := slices.Collect(itertools.Linspace(0.1, stop, n))
+
+	require.Len(t, got, n)
+	require.Equal(t, stop, got[n-1]) //nolint:testifylint
+}
+
+func TestLinspace_earlyStop(t *testing.T) {
+	got := slices.Collect(itertools.SliceUntil(itertools.Linspace(0, 1, 5), 2, 1))
+
+	require.Equal(t, []float64{0, 0.25}, got)
+}
+
+func TestLinspace_panicsOnNegative(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"n for Linspace must be non-negative",
+		func() { itertools.Linspace(0, 1, -1) },
+	)
+}
+
+func TestLogspace_panicsOnNegative(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"n for Logspace must be non-negative",
+		func() { itertools.Logspace(0, 1, -1, 10) },
+	)
+}
+
+func TestRangeFloat(t *testing.T) {
+	for _, tc := range []struct {
+		start    float64
+		end      float64
+		step     float64
+		expected []float64
+	}{
+		{0, 0, 1, nil},
+		{1, 0, 0.5, nil},
+		{0, 1, 0.25, []float64{0, 0.25, 0.5, 0.75}},
+		{0, 0.3, 0.1, []float64{0, 0.1, 0.2}},
+		{1, 0, -0.5, []float64{1, 0.5}},
+		{1, 1.3, 0.1, []float64{1, 1.1, 1.2}},
+		{1.3, 1, -0.1, []float64{1.3, 1.2, 1.1}},
+		{0, math.NaN(), 1, nil},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := slices.Collect(itertools.RangeFloat(tc.start, tc.end, tc.step))
+
+			require.InDeltaSlice(t, tc.expected, got, 1e-12)
+		})
+	}
+}
+
+func TestRangeFloat_noDrift(t *testing.T) {
+	// repeatedly adding 0.1 would give 0.9999999999999999 as the 11th value
+	got := slices.Collect(itertools.RangeFloat(0, 1, 0.1))
+
+	require.Len(t, got, 10)
+}
+
+func TestRangeFloat_earlyStop(t *testing.T) {
+	got := slices.Collect(itertools.SliceUntil(itertools.RangeFloat(0, 10, 1), 2, 1))
+
+	require.Equal(t, []float64{0, 1}, got)
+}
+
+func TestRangeFloat_panicsOnZeroStep(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"step for RangeFloat must be non-zero",
+		func() { itertools.RangeFloat(0, 1, 0) },
+	)
+}
@@ -0,0 +1,47 @@
+package itertools
+
+import (
+	"context"
+	"iter"
+)
+
+// Paginate returns a fallible [iter.Seq2] that yields the items of each page
+// returned by fetch, with a nil error, fetching pages only as they're needed.
+//
+// The first page is fetched with the zero value of Cursor, and each later
+// page with the next cursor returned for the page before it, so fetch can
+// implement either cursor or offset based pagination. Iteration ends after
+// the items of the page for which fetch reports done. If fetch fails, or ctx
+// is cancelled before fetching a page, the error is yielded (with the zero
+// value) as the final pair.
+func Paginate[T, Cursor any](
+	ctx context.Context,
+	fetch func(ctx context.Context, cursor Cursor) (items []T, next Cursor, done bool, err error),
+) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var (
+			zero   T
+			cursor Cursor
+		)
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			items, next, done, err := fetch(ctx, cursor)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if done {
+				return
+			}
+			cursor = next
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package itertools_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+)
+
+func ExamplePaginate() {
+	// a stand-in for a list endpoint returning up to 2 users per page, along
+	// with the offset of the next page
+	users := []string{"alice", "bob", "carol", "dave", "erin"}
+	listUsers := func(_ context.Context, offset int) ([]string, int, bool, error) {
+		end := min(offset+2, len(users))
+		return users[offset:end], end, end == len(users), nil
+	}
+
+	for user, err := range itertools.Paginate(context.Background(), listUsers) {
+		if err != nil {
+			fmt.Println("error:", err)
+			return
+		}
+		fmt.Println(user)
+	}
+
+	// output:
+	// alice
+	// bob
+	// carol
+	// dave
+	// erin
+}
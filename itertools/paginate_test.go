@@ -0,0 +1,94 @@
+package itertools_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+	"github.com/matthewhughes934/go-itertools/itertools/tuple"
+)
+
+var errFetch = errors.New("fetch error")
+
+// pagesOf returns a fetch function for Paginate serving pages, using the
+// page number as the cursor. Each page fetched is recorded in fetched.
+func pagesOf(
+	pages [][]int,
+	fetched *[]int,
+) func(context.Context, int) ([]int, int, bool, error) {
+	return func(_ context.Context, page int) ([]int, int, bool, error) {
+		*fetched = append(*fetched, page)
+		return pages[page], page + 1, page == len(pages)-1, nil
+	}
+}
+
+func okPairs(vals ...int) []tuple.Pair[int, error] {
+	var pairs []tuple.Pair[int, error]
+	for _, v := range vals {
+		pairs = append(pairs, tuple.NewPair[int, error](v, nil))
+	}
+	return pairs
+}
+
+func TestPaginate(t *testing.T) {
+	for _, tc := range []struct {
+		pages    [][]int
+		expected []int
+	}{
+		{[][]int{nil}, nil},
+		{[][]int{{1, 2}}, []int{1, 2}},
+		{[][]int{{1, 2}, nil, {3}}, []int{1, 2, 3}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			var fetched []int
+			seq := itertools.Paginate(context.Background(), pagesOf(tc.pages, &fetched))
+
+			got := tuple.CollectPairs(seq)
+
+			require.Equal(t, okPairs(tc.expected...), got)
+			require.Len(t, fetched, len(tc.pages))
+		})
+	}
+}
+
+func TestPaginate_fetchError(t *testing.T) {
+	fetch := func(_ context.Context, page int) ([]int, int, bool, error) {
+		if page == 1 {
+			return []int{3}, 2, false, errFetch
+		}
+		return []int{1, 2}, page + 1, false, nil
+	}
+
+	got := tuple.CollectPairs(itertools.Paginate(context.Background(), fetch))
+
+	require.Equal(t, append(okPairs(1, 2), tuple.NewPair(0, errFetch)), got)
+}
+
+func TestPaginate_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var fetched []int
+	var got []tuple.Pair[int, error]
+
+	for v, err := range itertools.Paginate(ctx, pagesOf([][]int{{1, 2}, {3}}, &fetched)) {
+		got = append(got, tuple.NewPair(v, err))
+		cancel()
+	}
+
+	require.Equal(t, append(okPairs(1, 2), tuple.NewPair(0, context.Canceled)), got)
+	require.Equal(t, []int{0}, fetched)
+}
+
+func TestPaginate_earlyStop(t *testing.T) {
+	var fetched []int
+	seq := itertools.Paginate(context.Background(), pagesOf([][]int{{1, 2}, {3}, {4}}, &fetched))
+
+	got := tuple.CollectPairs(itertools.SliceUntil2(seq, 3, 1))
+
+	require.Equal(t, okPairs(1, 2, 3), got)
+	require.Equal(t, []int{0, 1}, fetched)
+}
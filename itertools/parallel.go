@@ -0,0 +1,416 @@
+package itertools
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"runtime"
+	"sync"
+
+	"github.com/matthewhughes934/go-itertools/itertools/tuple"
+)
+
+// Option configures the concurrent and channel functions such as [ParMap],
+// [ParForEach], [ToChan] and [Buffered]. Each function documents which
+// options it supports, and ignores the rest.
+//
+// The stages of the pipeline package are configured by that package's own
+// options, which have different defaults.
+type Option func(*parConfig)
+
+type parConfig struct {
+	workers     int
+	buffer      int
+	ordered     bool
+	stopOnError bool
+}
+
+func newParConfig(opts []Option) parConfig {
+	config := parConfig{
+		workers:     runtime.GOMAXPROCS(0),
+		ordered:     true,
+		stopOnError: true,
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	return config
+}
+
+// WithWorkers sets the maximum number of concurrent calls, the default is
+// [runtime.GOMAXPROCS].
+//
+// Panics if n is not positive.
+func WithWorkers(n int) Option {
+	if n <= 0 {
+		panic("n for WithWorkers must be a positive integer")
+	}
+	return func(c *parConfig) { c.workers = n }
+}
+
+// WithBuffer sets how many completed values may be queued waiting for the
+// consumer, the default is 0.
+//
+// Panics if n is negative.
+func WithBuffer(n int) Option {
+	if n < 0 {
+		panic("n for WithBuffer must be non-negative")
+	}
+	return func(c *parConfig) { c.buffer = n }
+}
+
+// WithOrdered sets whether results are yielded in the same order as the
+// input, the default is true.
+func WithOrdered(ordered bool) Option {
+	return func(c *parConfig) { c.ordered = ordered }
+}
+
+// WithStopOnError sets whether processing stops after the first error,
+// the default is true. Otherwise, all errors are reported.
+func WithStopOnError(stop bool) Option {
+	return func(c *parConfig) { c.stopOnError = stop }
+}
+
+type parJob[V1, V2 any] struct {
+	value  V1
+	result chan V2
+}
+
+// ParMap returns a [iter.Seq] that yields the result of calling f on each
+// value in seq, like [Map], but with calls to f running concurrently. By
+// default, results are yielded in the same order as the values of seq, so a
+// slow call to f delays later results even if they are ready.
+//
+// Iteration stops once seq is exhausted or ctx is cancelled. If seq is
+// exhausted or the caller stops iterating, on return all background
+// goroutines have exited and seq has been stopped. On cancellation, it
+// returns without waiting for seq or f to produce a value, and the
+// goroutines exit in the background once they do.
+//
+// Supports [WithWorkers], [WithBuffer] and [WithOrdered].
+func ParMap[V1, V2 any](
+	ctx context.Context,
+	f func(V1) V2,
+	seq iter.Seq[V1],
+	opts ...Option,
+) iter.Seq[V2] {
+	config := newParConfig(opts)
+	if !config.ordered {
+		return parMapUnordered(ctx, f, seq, config)
+	}
+	return parMapOrdered(ctx, f, seq, config)
+}
+
+// ParMapUnordered is like [ParMap] but yields results as soon as they are
+// ready, so they may be in a different order to the values of seq, i.e. it
+// implies [WithOrdered] false.
+func ParMapUnordered[V1, V2 any](
+	ctx context.Context,
+	f func(V1) V2,
+	seq iter.Seq[V1],
+	opts ...Option,
+) iter.Seq[V2] {
+	config := newParConfig(opts)
+	return parMapUnordered(ctx, f, seq, config)
+}
+
+func parMapOrdered[V1, V2 any](
+	ctx context.Context,
+	f func(V1) V2,
+	seq iter.Seq[V1],
+	config parConfig,
+) iter.Seq[V2] {
+	return func(yield func(V2) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		defer waitUnlessCancelled(ctx, cancel, &wg)
+
+		jobs := make(chan parJob[V1, V2])
+		// results in input order, bounding how far ahead of the consumer
+		// the workers can get
+		pending := make(chan chan V2, config.workers+config.buffer)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(jobs)
+			defer close(pending)
+			for v := range seq {
+				// buffered so workers never block on sending a result
+				result := make(chan V2, 1)
+				select {
+				case pending <- result:
+				case <-ctx.Done():
+					return
+				}
+				select {
+				case jobs <- parJob[V1, V2]{v, result}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+
+		for range config.workers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for job := range jobs {
+					job.result <- f(job.value)
+				}
+			}()
+		}
+
+		for {
+			var result chan V2
+			select {
+			case r, ok := <-pending:
+				if !ok {
+					return
+				}
+				result = r
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case v := <-result:
+				if ctx.Err() != nil || !yield(v) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// waitUnlessCancelled cancels ctx, via its cancel, and then waits on wg,
+// unless ctx was already cancelled. Then the goroutines may be blocked
+// waiting on the caller's code, e.g. for a sequence to produce a value, so
+// rather than wait for that they are left to exit in the background.
+func waitUnlessCancelled(ctx context.Context, cancel context.CancelFunc, wg *sync.WaitGroup) {
+	wait := ctx.Err() == nil
+	cancel()
+	if wait {
+		wg.Wait()
+	}
+}
+
+func parMapUnordered[V1, V2 any](
+	ctx context.Context,
+	f func(V1) V2,
+	seq iter.Seq[V1],
+	config parConfig,
+) iter.Seq[V2] {
+	return func(yield func(V2) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		defer waitUnlessCancelled(ctx, cancel, &wg)
+
+		jobs := make(chan V1)
+		results := make(chan V2, config.buffer)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(jobs)
+			for v := range seq {
+				select {
+				case jobs <- v:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+
+		var workerWg sync.WaitGroup
+		for range config.workers {
+			workerWg.Add(1)
+			go func() {
+				defer workerWg.Done()
+				for v := range jobs {
+					select {
+					case results <- f(v):
+					case <-ctx.Done():
+						return
+					}
+				}
+			}()
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			workerWg.Wait()
+			close(results)
+		}()
+
+		for {
+			select {
+			case v, ok := <-results:
+				if !ok || ctx.Err() != nil || !yield(v) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// ParForEach calls f on each value of seq with calls running concurrently,
+// returning once seq is exhausted and all calls have finished.
+//
+// By default, if any call to f returns an error, the context passed to the
+// other calls is cancelled, no further values are taken from seq, and the
+// first error is returned. With [WithStopOnError] false, all values are
+// processed and all errors are returned, joined by [errors.Join]. If ctx is
+// cancelled, iteration stops and, if no call to f failed, ctx's error is
+// returned.
+//
+// Supports [WithWorkers], [WithBuffer] and [WithStopOnError].
+func ParForEach[V any](
+	ctx context.Context,
+	seq iter.Seq[V],
+	f func(context.Context, V) error,
+	opts ...Option,
+) error {
+	config := newParConfig(opts)
+	parentCtx := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg    sync.WaitGroup
+		errMu sync.Mutex
+		errs  []error
+	)
+	jobs := make(chan V, config.buffer)
+	for range config.workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v := range jobs {
+				if err := f(ctx, v); err != nil {
+					errMu.Lock()
+					switch {
+					case !config.stopOnError:
+						errs = append(errs, err)
+					case len(errs) == 0:
+						errs = append(errs, err)
+						cancel()
+					}
+					errMu.Unlock()
+				}
+			}
+		}()
+	}
+
+	func() {
+		defer close(jobs)
+		for v := range seq {
+			select {
+			case jobs <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return parentCtx.Err()
+}
+
+// ParFilter is like [Filter] but with calls to filterFunc running
+// concurrently, see [ParMap]. By default, the values for which filterFunc is
+// true are yielded in the same order as in seq.
+//
+// Supports [WithWorkers], [WithBuffer] and [WithOrdered].
+func ParFilter[V any](
+	ctx context.Context,
+	filterFunc func(V) bool,
+	seq iter.Seq[V],
+	opts ...Option,
+) iter.Seq[V] {
+	return filterChecked(ParMap(ctx, checkFilter(filterFunc), seq, opts...))
+}
+
+// ParFilterUnordered is like [ParFilter] but yields values as soon as
+// filterFunc has been checked, see [ParMapUnordered].
+func ParFilterUnordered[V any](
+	ctx context.Context,
+	filterFunc func(V) bool,
+	seq iter.Seq[V],
+	opts ...Option,
+) iter.Seq[V] {
+	return filterChecked(ParMapUnordered(ctx, checkFilter(filterFunc), seq, opts...))
+}
+
+func checkFilter[V any](filterFunc func(V) bool) func(V) tuple.Pair[V, bool] {
+	return func(v V) tuple.Pair[V, bool] { return tuple.NewPair(v, filterFunc(v)) }
+}
+
+func filterChecked[V any](seq iter.Seq[tuple.Pair[V, bool]]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for checked := range seq {
+			if checked.Second && !yield(checked.First) {
+				return
+			}
+		}
+	}
+}
+
+// ParChunks splits seq into chunks of length size, except possibly the last
+// which holds whatever values remain, and calls f on each chunk concurrently,
+// like [ParMap]. It returns a [iter.Seq2] yielding the result of each call
+// to f, by default in the same order as the chunks.
+//
+// By default, iteration stops after yielding the first error. With
+// [WithStopOnError] false, chunks after an error are still processed.
+//
+// Supports [WithWorkers], [WithBuffer], [WithOrdered] and
+// [WithStopOnError].
+//
+// Panics if size is not positive.
+func ParChunks[V any, R any](
+	ctx context.Context,
+	seq iter.Seq[V],
+	size int,
+	f func([]V) ([]R, error),
+	opts ...Option,
+) iter.Seq2[[]R, error] {
+	if size <= 0 {
+		panic("size for ParChunks must be a positive integer")
+	}
+	config := newParConfig(opts)
+	process := func(chunk []V) tuple.Pair[[]R, error] { return tuple.NewPair(f(chunk)) }
+
+	return func(yield func([]R, error) bool) {
+		for res := range ParMap(ctx, process, chunks(seq, size), opts...) {
+			rs, err := res.Unpack()
+			if !yield(rs, err) || (err != nil && config.stopOnError) {
+				return
+			}
+		}
+	}
+}
+
+func chunks[V any](seq iter.Seq[V], size int) iter.Seq[[]V] {
+	return func(yield func([]V) bool) {
+		chunk := make([]V, 0, size)
+		for v := range seq {
+			chunk = append(chunk, v)
+			if len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]V, 0, size)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
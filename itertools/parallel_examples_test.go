@@ -0,0 +1,192 @@
+package itertools_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+)
+
+func ExampleParMap() {
+	urls := slices.Values([]string{"a.example", "b.example", "c.example"})
+	// e.g. an expensive network request
+	fetch := func(url string) string { return strings.ToUpper(url) }
+
+	bodies := itertools.ParMap(context.Background(), fetch, urls, itertools.WithWorkers(2))
+	for body := range bodies {
+		fmt.Println(body)
+	}
+
+	// output:
+	// A.EXAMPLE
+	// B.EXAMPLE
+	// C.EXAMPLE
+}
+
+func ExampleParMapUnordered() {
+	urls := slices.Values([]string{"a.example", "b.example", "c.example"})
+	// e.g. an expensive network request
+	fetch := func(url string) string { return strings.ToUpper(url) }
+
+	for body := range itertools.ParMapUnordered(context.Background(), fetch, urls) {
+		fmt.Println(body)
+	}
+
+	// unordered output:
+	// A.EXAMPLE
+	// B.EXAMPLE
+	// C.EXAMPLE
+}
+
+func ExampleParForEach() {
+	paths := slices.Values([]string{"a.txt", "b.txt", "missing.txt"})
+	upload := func(_ context.Context, path string) error {
+		if path == "missing.txt" {
+			return fmt.Errorf("no such file: %s", path)
+		}
+		return nil
+	}
+
+	err := itertools.ParForEach(context.Background(), paths, upload, itertools.WithWorkers(2))
+	fmt.Println(err)
+
+	// output:
+	// no such file: missing.txt
+}
+
+func ExampleParFilter() {
+	names := slices.Values([]string{"alice", "bob", "carol"})
+	// e.g. an expensive remote lookup
+	exists := func(name string) bool { return name != "bob" }
+
+	for name := range itertools.ParFilter(context.Background(), exists, names) {
+		fmt.Println(name)
+	}
+
+	// output:
+	// alice
+	// carol
+}
+
+func ExampleParFilterUnordered() {
+	names := slices.Values([]string{"alice", "bob", "carol"})
+	// e.g. an expensive remote lookup
+	exists := func(name string) bool { return name != "bob" }
+
+	for name := range itertools.ParFilterUnordered(context.Background(), exists, names) {
+		fmt.Println(name)
+	}
+
+	// unordered output:
+	// alice
+	// carol
+}
+
+func ExampleWithStopOnError() {
+	paths := slices.Values([]string{"a.txt", "missing.txt", "b.txt", "other.txt"})
+	var mu sync.Mutex
+	var uploaded []string
+	upload := func(_ context.Context, path string) error {
+		if strings.HasPrefix(path, "missing") || strings.HasPrefix(path, "other") {
+			return fmt.Errorf("no such file: %s", path)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		uploaded = append(uploaded, path)
+		return nil
+	}
+
+	err := itertools.ParForEach(
+		context.Background(),
+		paths,
+		upload,
+		itertools.WithWorkers(1),
+		itertools.WithStopOnError(false),
+	)
+	fmt.Println(uploaded)
+	fmt.Println(err)
+
+	// output:
+	// [a.txt b.txt]
+	// no such file: missing.txt
+	// no such file: other.txt
+}
+
+func ExampleWithOrdered() {
+	names := slices.Values([]string{"alice", "bob", "carol"})
+
+	upper := itertools.ParMap(
+		context.Background(),
+		strings.ToUpper,
+		names,
+		itertools.WithWorkers(3),
+		itertools.WithOrdered(false),
+	)
+	for name := range upper {
+		fmt.Println(name)
+	}
+
+	// unordered output:
+	// ALICE
+	// BOB
+	// CAROL
+}
+
+func ExampleWithWorkers() {
+	var running, maxRunning atomic.Int64
+	work := func(context.Context, int) error {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for current := maxRunning.Load(); n > current; current = maxRunning.Load() {
+			maxRunning.CompareAndSwap(current, n)
+		}
+		return nil
+	}
+
+	err := itertools.ParForEach(
+		context.Background(),
+		itertools.Range(0, 100, 1),
+		work,
+		itertools.WithWorkers(4),
+	)
+	fmt.Println(err, maxRunning.Load() <= 4)
+
+	// output:
+	// <nil> true
+}
+
+func ExampleWithBuffer() {
+	// allow up to 10 results to be computed ahead of the consumer
+	squares := itertools.ParMap(
+		context.Background(),
+		func(x int) int { return x * x },
+		itertools.Range(0, 5, 1),
+		itertools.WithBuffer(10),
+	)
+
+	fmt.Println(slices.Collect(squares))
+
+	// output:
+	// [0 1 4 9 16]
+}
+
+func ExampleParChunks() {
+	records := slices.Values([]string{"a", "b", "c", "d", "e"})
+	// e.g. a bulk API accepting up to 2 records at a time
+	insert := func(batch []string) ([]string, error) {
+		return []string{strings.Join(batch, "+")}, nil
+	}
+
+	for ids, err := range itertools.ParChunks(context.Background(), records, 2, insert) {
+		fmt.Println(ids, err)
+	}
+
+	// output:
+	// [a+b] <nil>
+	// [c+d] <nil>
+	// [e] <nil>
+}
@@ -0,0 +1,631 @@
+package itertools_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+)
+
+func double(x int) int { return x * 2 }
+
+func TestParMap(t *testing.T) {
+	for _, tc := range []struct {
+		vals []int
+		opts []itertools.Option
+	}{
+		{nil, nil},
+		{[]int{1, 2, 3}, nil},
+		{[]int{1, 2, 3}, []itertools.Option{itertools.WithWorkers(1)}},
+		{[]int{1, 2, 3}, []itertools.Option{itertools.WithWorkers(2), itertools.WithBuffer(2)}},
+		{slices.Collect(itertools.Range(0, 100, 1)), []itertools.Option{itertools.WithWorkers(8)}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			expected := slices.Collect(itertools.Map(double, slices.Values(tc.vals)))
+
+			got := slices.Collect(
+				itertools.ParMap(context.Background(), double, slices.Values(tc.vals), tc.opts...),
+			)
+
+			require.Equal(t, expected, got)
+		})
+	}
+}
+
+func TestParMap_unordered(t *testing.T) {
+	vals := slices.Collect(itertools.Range(0, 100, 1))
+	expected := slices.Collect(itertools.Map(double, slices.Values(vals)))
+
+	got := slices.Collect(
+		itertools.ParMap(
+			context.Background(),
+			double,
+			slices.Values(vals),
+			itertools.WithOrdered(false),
+		),
+	)
+
+	require.ElementsMatch(t, expected, got)
+}
+
+func TestParMap_preservesOrder(t *testing.T) {
+	// earlier values take longer, so finish last
+	f := func(x int) int {
+		time.Sleep(time.Duration(5-x) * time.Millisecond)
+		return x
+	}
+	vals := []int{0, 1, 2, 3, 4}
+
+	seq := itertools.ParMap(context.Background(), f, slices.Values(vals), itertools.WithWorkers(5))
+
+	got := slices.Collect(seq)
+
+	require.Equal(t, vals, got)
+}
+
+func TestParMap_boundedConcurrency(t *testing.T) {
+	var running, maxRunning atomic.Int64
+	f := func(x int) int {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			current := maxRunning.Load()
+			if n <= current || maxRunning.CompareAndSwap(current, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		return x
+	}
+
+	seq := itertools.ParMap(
+		context.Background(),
+		f,
+		itertools.Range(0, 20, 1),
+		itertools.WithWorkers(3),
+	)
+	got := slices.Collect(seq)
+
+	require.Len(t, got, 20)
+	require.LessOrEqual(t, maxRunning.Load(), int64(3))
+}
+
+func TestParMap_earlyStop(t *testing.T) {
+	var stopped bool
+	seq := func(yield func(int) bool) {
+		defer func() { stopped = true }()
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	parSeq := itertools.ParMap(context.Background(), double, seq, itertools.WithWorkers(4))
+
+	got := slices.Collect(itertools.SliceUntil(parSeq, 3, 1))
+
+	require.Equal(t, []int{0, 2, 4}, got)
+	require.True(t, stopped)
+}
+
+func TestParMap_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var got []int
+	for v := range itertools.ParMap(ctx, double, itertools.RangeFrom(0, 1)) {
+		got = append(got, v)
+		cancel()
+	}
+
+	require.Equal(t, []int{0}, got)
+}
+
+// countFrom yields the integers from 0, closing reached once n has been
+// produced
+func countFrom(n int, reached chan<- struct{}) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			if i == n {
+				close(reached)
+			}
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestParMap_cancelledWhileYielding(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reached := make(chan struct{})
+
+	var got []int
+	seq := itertools.ParMap(ctx, double, countFrom(2, reached), itertools.WithWorkers(1))
+	for v := range seq {
+		// wait until the workers are blocked on the consumer
+		<-reached
+		got = append(got, v)
+		cancel()
+	}
+
+	require.Equal(t, []int{0}, got)
+}
+
+func TestParMap_cancelledWhileWaiting(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	block := make(chan struct{})
+	f := func(x int) int {
+		<-block
+		return x
+	}
+
+	go func() {
+		time.Sleep(time.Millisecond)
+		cancel()
+		// the pending call to f finishes in the background
+		close(block)
+	}()
+	seq := itertools.ParMap(ctx, f, slices.Values([]int{1, 2, 3}), itertools.WithWorkers(1))
+	got := slices.Collect(seq)
+
+	require.Empty(t, got)
+}
+
+func TestParMap_cancelledWhileBlocked(t *testing.T) {
+	for _, ordered := range []bool{true, false} {
+		t.Run(fmt.Sprintf("ordered %t", ordered), func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			seq, unblock, stopped := blockedSeq()
+
+			got := slices.Collect(
+				itertools.ParMap(ctx, double, itertools.Keys(seq), itertools.WithOrdered(ordered)),
+			)
+
+			require.Empty(t, got)
+			unblock()
+			<-stopped
+		})
+	}
+}
+
+func TestParMapUnordered(t *testing.T) {
+	for _, tc := range []struct {
+		vals []int
+		opts []itertools.Option
+	}{
+		{nil, nil},
+		{[]int{1, 2, 3}, nil},
+		{[]int{1, 2, 3}, []itertools.Option{itertools.WithWorkers(1)}},
+		{[]int{1, 2, 3}, []itertools.Option{itertools.WithWorkers(2), itertools.WithBuffer(2)}},
+		// ordered is ignored
+		{[]int{1, 2, 3}, []itertools.Option{itertools.WithOrdered(true)}},
+		{slices.Collect(itertools.Range(0, 100, 1)), []itertools.Option{itertools.WithWorkers(8)}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			expected := slices.Collect(itertools.Map(double, slices.Values(tc.vals)))
+
+			got := slices.Collect(
+				itertools.ParMapUnordered(
+					context.Background(),
+					double,
+					slices.Values(tc.vals),
+					tc.opts...,
+				),
+			)
+
+			require.ElementsMatch(t, expected, got)
+		})
+	}
+}
+
+func TestParMapUnordered_yieldsWhenReady(t *testing.T) {
+	block := make(chan struct{})
+	f := func(x int) int {
+		if x == 0 {
+			<-block
+		}
+		return x
+	}
+	seq := itertools.ParMapUnordered(
+		context.Background(),
+		f,
+		slices.Values([]int{0, 1}),
+		itertools.WithWorkers(2),
+	)
+
+	var got []int
+	for v := range seq {
+		got = append(got, v)
+		if v == 1 {
+			close(block)
+		}
+	}
+
+	require.Equal(t, []int{1, 0}, got)
+}
+
+func TestParMapUnordered_earlyStop(t *testing.T) {
+	var stopped bool
+	seq := func(yield func(int) bool) {
+		defer func() { stopped = true }()
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	parSeq := itertools.ParMapUnordered(context.Background(), double, seq, itertools.WithWorkers(4))
+
+	got := slices.Collect(itertools.SliceUntil(parSeq, 3, 1))
+
+	require.Len(t, got, 3)
+	require.True(t, stopped)
+}
+
+func TestParMapUnordered_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var got []int
+	for v := range itertools.ParMapUnordered(ctx, double, itertools.RangeFrom(0, 1)) {
+		got = append(got, v)
+		cancel()
+	}
+
+	require.Len(t, got, 1)
+}
+
+func TestParMapUnordered_cancelledWhileYielding(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reached := make(chan struct{})
+
+	var got []int
+	seq := itertools.ParMapUnordered(ctx, double, countFrom(2, reached), itertools.WithWorkers(1))
+	for v := range seq {
+		// wait until the workers are blocked on the consumer
+		<-reached
+		got = append(got, v)
+		cancel()
+	}
+
+	require.Equal(t, []int{0}, got)
+}
+
+func TestParForEach(t *testing.T) {
+	for _, tc := range []struct {
+		vals []int
+		opts []itertools.Option
+	}{
+		{nil, nil},
+		{[]int{1, 2, 3}, nil},
+		{[]int{1, 2, 3}, []itertools.Option{itertools.WithWorkers(1), itertools.WithBuffer(1)}},
+		{slices.Collect(itertools.Range(0, 100, 1)), []itertools.Option{itertools.WithWorkers(8)}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			var (
+				mu  sync.Mutex
+				got []int
+			)
+			f := func(_ context.Context, v int) error {
+				mu.Lock()
+				defer mu.Unlock()
+				got = append(got, v)
+				return nil
+			}
+
+			err := itertools.ParForEach(
+				context.Background(),
+				slices.Values(tc.vals),
+				f,
+				tc.opts...,
+			)
+
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.vals, got)
+		})
+	}
+}
+
+func TestParForEach_error(t *testing.T) {
+	expectedErr := errors.New("bad value")
+	var stopped bool
+	seq := func(yield func(int) bool) {
+		defer func() { stopped = true }()
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	f := func(ctx context.Context, v int) error {
+		if v == 3 {
+			return expectedErr
+		}
+		// other calls are cancelled
+		if v > 3 {
+			<-ctx.Done()
+		}
+		return nil
+	}
+
+	err := itertools.ParForEach(context.Background(), seq, f, itertools.WithWorkers(2))
+
+	require.ErrorIs(t, err, expectedErr)
+	require.True(t, stopped)
+}
+
+func TestParForEach_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	f := func(_ context.Context, v int) error {
+		if v == 3 {
+			cancel()
+		}
+		return nil
+	}
+
+	err := itertools.ParForEach(ctx, itertools.RangeFrom(0, 1), f, itertools.WithWorkers(2))
+
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestParForEach_allErrors(t *testing.T) {
+	errOne := errors.New("one")
+	errThree := errors.New("three")
+	var got []int
+	var mu sync.Mutex
+	f := func(ctx context.Context, v int) error {
+		require.NoError(t, ctx.Err())
+		mu.Lock()
+		defer mu.Unlock()
+		got = append(got, v)
+		switch v {
+		case 1:
+			return errOne
+		case 3:
+			return errThree
+		default:
+			return nil
+		}
+	}
+
+	err := itertools.ParForEach(
+		context.Background(),
+		slices.Values([]int{1, 2, 3, 4}),
+		f,
+		itertools.WithStopOnError(false),
+	)
+
+	require.ErrorIs(t, err, errOne)
+	require.ErrorIs(t, err, errThree)
+	require.ElementsMatch(t, []int{1, 2, 3, 4}, got)
+}
+
+func TestParForEach_firstErrorOnly(t *testing.T) {
+	errs := []error{errors.New("first"), errors.New("second")}
+	var calls atomic.Int64
+	f := func(context.Context, int) error {
+		return errs[calls.Add(1)-1]
+	}
+
+	err := itertools.ParForEach(
+		context.Background(),
+		slices.Values([]int{1, 2}),
+		f,
+		itertools.WithWorkers(1),
+		// the second value may be queued before the first fails
+		itertools.WithBuffer(1),
+	)
+
+	require.ErrorIs(t, err, errs[0])
+	require.NotErrorIs(t, err, errs[1])
+}
+
+func TestParFilter(t *testing.T) {
+	for _, tc := range []struct {
+		vals []int
+		opts []itertools.Option
+	}{
+		{nil, nil},
+		{[]int{1, 2, 3, 4}, []itertools.Option{itertools.WithWorkers(1)}},
+		{slices.Collect(itertools.Range(0, 100, 1)), []itertools.Option{itertools.WithWorkers(8)}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			expected := slices.Collect(itertools.Filter(isEven, slices.Values(tc.vals)))
+
+			got := slices.Collect(
+				itertools.ParFilter(
+					context.Background(),
+					isEven,
+					slices.Values(tc.vals),
+					tc.opts...,
+				),
+			)
+
+			require.Equal(t, expected, got)
+		})
+	}
+}
+
+func TestParFilter_earlyStop(t *testing.T) {
+	seq := itertools.ParFilter(context.Background(), isEven, itertools.RangeFrom(0, 1))
+
+	got := slices.Collect(itertools.SliceUntil(seq, 2, 1))
+
+	require.Equal(t, []int{0, 2}, got)
+}
+
+func TestParFilterUnordered(t *testing.T) {
+	for _, tc := range []struct {
+		vals []int
+		opts []itertools.Option
+	}{
+		{nil, nil},
+		{[]int{1, 2, 3, 4}, []itertools.Option{itertools.WithWorkers(1)}},
+		{slices.Collect(itertools.Range(0, 100, 1)), []itertools.Option{itertools.WithWorkers(8)}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			expected := slices.Collect(itertools.Filter(isEven, slices.Values(tc.vals)))
+
+			got := slices.Collect(
+				itertools.ParFilterUnordered(
+					context.Background(),
+					isEven,
+					slices.Values(tc.vals),
+					tc.opts...,
+				),
+			)
+
+			require.ElementsMatch(t, expected, got)
+		})
+	}
+}
+
+func TestParFilterUnordered_earlyStop(t *testing.T) {
+	seq := itertools.ParFilterUnordered(context.Background(), isEven, itertools.RangeFrom(0, 1))
+
+	got := slices.Collect(itertools.SliceUntil(seq, 2, 1))
+
+	require.Len(t, got, 2)
+	for _, v := range got {
+		require.True(t, isEven(v))
+	}
+}
+
+func TestWithWorkers_invalid(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"n for WithWorkers must be a positive integer",
+		func() { itertools.WithWorkers(0) },
+	)
+}
+
+func TestWithBuffer_invalid(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"n for WithBuffer must be non-negative",
+		func() { itertools.WithBuffer(-1) },
+	)
+}
+
+func sumChunk(chunk []int) ([]int, error) {
+	total := 0
+	for _, x := range chunk {
+		total += x
+	}
+	return []int{total}, nil
+}
+
+func TestParChunks(t *testing.T) {
+	for _, tc := range []struct {
+		vals     []int
+		size     int
+		opts     []itertools.Option
+		expected [][]int
+	}{
+		{nil, 1, nil, nil},
+		{[]int{1, 2, 3}, 1, nil, [][]int{{1}, {2}, {3}}},
+		{[]int{1, 2, 3}, 2, nil, [][]int{{3}, {3}}},
+		{[]int{1, 2, 3, 4}, 2, []itertools.Option{itertools.WithWorkers(1)}, [][]int{{3}, {7}}},
+		{[]int{1, 2, 3}, 5, []itertools.Option{itertools.WithWorkers(2)}, [][]int{{6}}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			seq := itertools.ParChunks(
+				context.Background(),
+				slices.Values(tc.vals),
+				tc.size,
+				sumChunk,
+				tc.opts...,
+			)
+
+			var got [][]int
+			for res, err := range seq {
+				require.NoError(t, err)
+				got = append(got, res)
+			}
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestParChunks_earlyStop(t *testing.T) {
+	seq := itertools.ParChunks(context.Background(), itertools.RangeFrom(0, 1), 2, sumChunk)
+
+	var got [][]int
+	for res, err := range seq {
+		require.NoError(t, err)
+		got = append(got, res)
+		if len(got) == 2 {
+			break
+		}
+	}
+
+	require.Equal(t, [][]int{{1}, {5}}, got)
+}
+
+func TestParChunks_error(t *testing.T) {
+	expectedErr := errors.New("bad chunk")
+	f := func(chunk []int) ([]int, error) {
+		if chunk[0] == 2 {
+			return nil, expectedErr
+		}
+		return chunk, nil
+	}
+
+	for _, tc := range []struct {
+		opts         []itertools.Option
+		expectedVals [][]int
+	}{
+		{nil, [][]int{{0, 1}, nil}},
+		{
+			[]itertools.Option{itertools.WithStopOnError(false)},
+			[][]int{{0, 1}, nil, {4, 5}},
+		},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			seq := itertools.ParChunks(
+				context.Background(),
+				itertools.Range(0, 6, 1),
+				2,
+				f,
+				tc.opts...,
+			)
+
+			var vals [][]int
+			var errs []error
+			for res, err := range seq {
+				vals = append(vals, res)
+				errs = append(errs, err)
+			}
+
+			require.Equal(t, tc.expectedVals, vals)
+			require.ErrorIs(t, errs[1], expectedErr)
+			require.NoError(t, errors.Join(slices.Delete(errs, 1, 2)...))
+		})
+	}
+}
+
+func TestParChunks_invalidSize(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"size for ParChunks must be a positive integer",
+		func() { itertools.ParChunks(context.Background(), slices.Values([]int{1}), 0, sumChunk) },
+	)
+}
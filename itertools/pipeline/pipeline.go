@@ -0,0 +1,273 @@
+// Package pipeline provides staged, concurrent processing of sequences.
+//
+// A [Pipeline] is declared from a source sequence with [From] and extended
+// with stages such as [Map], [Filter] and [Batch], each of which runs its own
+// workers connected to the next stage by a bounded queue. Nothing runs until
+// the pipeline is consumed with [Pipeline.Run] or [Pipeline.Collect], at which
+// point the first error from any stage cancels the whole pipeline and all
+// errors are returned together.
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"sync"
+)
+
+// Pipeline is a declared sequence of stages producing values of type V. It
+// may be run any number of times, each run iterating the source again.
+type Pipeline[V any] struct {
+	start func(r *runner) <-chan V
+}
+
+// Option configures a stage of a [Pipeline].
+//
+// These are separate from the options of the itertools package's concurrent
+// functions because stages behave differently: every stage of a pipeline
+// runs at once, and a stage with several workers doesn't preserve the order
+// of values, so stages default to a single worker rather than one per CPU.
+type Option func(*stageConfig)
+
+type stageConfig struct {
+	workers int
+	buffer  int
+}
+
+// WithWorkers sets the number of workers running a stage concurrently, the
+// default is 1. With more than one worker, the stage doesn't preserve the
+// order of values.
+//
+// Panics if n is not positive.
+func WithWorkers(n int) Option {
+	if n <= 0 {
+		panic("n for WithWorkers must be a positive integer")
+	}
+	return func(c *stageConfig) { c.workers = n }
+}
+
+// WithBuffer sets the size of the queue holding the output of a stage
+// until the next stage is ready for it, the default is 0, i.e. unbuffered.
+//
+// Panics if n is negative.
+func WithBuffer(n int) Option {
+	if n < 0 {
+		panic("n for WithBuffer must be non-negative")
+	}
+	return func(c *stageConfig) { c.buffer = n }
+}
+
+func newStageConfig(opts []Option) stageConfig {
+	config := stageConfig{workers: 1}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	return config
+}
+
+// runner holds the state of a single run of a pipeline.
+type runner struct {
+	ctx    context.Context //nolint:containedctx
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	errMu sync.Mutex
+	errs  []error
+}
+
+func (r *runner) spawn(f func()) {
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		f()
+	}()
+}
+
+// fail records err and cancels the run. Once the run is cancelled, errors
+// that only report that cancellation, e.g. from stages returning
+// ctx.Err(), aren't recorded, so they don't hide the actual failure.
+func (r *runner) fail(err error) {
+	r.errMu.Lock()
+	defer r.errMu.Unlock()
+	if ctxErr := r.ctx.Err(); ctxErr != nil && errors.Is(err, ctxErr) {
+		return
+	}
+	r.errs = append(r.errs, err)
+	r.cancel()
+}
+
+func send[V any](ctx context.Context, ch chan<- V, v V) bool {
+	select {
+	case ch <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// From returns a [Pipeline] whose source is seq.
+func From[V any](seq iter.Seq[V]) *Pipeline[V] {
+	return &Pipeline[V]{
+		start: func(r *runner) <-chan V {
+			out := make(chan V)
+			r.spawn(func() {
+				defer close(out)
+				for v := range seq {
+					if !send(r.ctx, out, v) {
+						return
+					}
+				}
+			})
+			return out
+		},
+	}
+}
+
+// stage adds a stage to p where each value is passed to process along with
+// a function to send values on to the next stage, which returns false once
+// the run has been cancelled.
+func stage[In, Out any](
+	p *Pipeline[In],
+	opts []Option,
+	process func(ctx context.Context, v In, emit func(Out) bool) error,
+) *Pipeline[Out] {
+	config := newStageConfig(opts)
+
+	return &Pipeline[Out]{
+		start: func(r *runner) <-chan Out {
+			in := p.start(r)
+			out := make(chan Out, config.buffer)
+			emit := func(v Out) bool { return send(r.ctx, out, v) }
+
+			var workers sync.WaitGroup
+			for range config.workers {
+				workers.Add(1)
+				r.spawn(func() {
+					defer workers.Done()
+					for v := range in {
+						if err := process(r.ctx, v, emit); err != nil {
+							r.fail(err)
+							return
+						}
+					}
+				})
+			}
+			r.spawn(func() {
+				workers.Wait()
+				close(out)
+			})
+			return out
+		},
+	}
+}
+
+// Map adds a stage to p that passes each value through f. If f returns an
+// error, the pipeline is stopped.
+func Map[In, Out any](
+	p *Pipeline[In],
+	f func(context.Context, In) (Out, error),
+	opts ...Option,
+) *Pipeline[Out] {
+	return stage(p, opts, func(ctx context.Context, v In, emit func(Out) bool) error {
+		res, err := f(ctx, v)
+		if err != nil {
+			return err
+		}
+		emit(res)
+		return nil
+	})
+}
+
+// Filter adds a stage to p that keeps only the values for which f returns
+// true. If f returns an error, the pipeline is stopped.
+func Filter[V any](
+	p *Pipeline[V],
+	f func(context.Context, V) (bool, error),
+	opts ...Option,
+) *Pipeline[V] {
+	return stage(p, opts, func(ctx context.Context, v V, emit func(V) bool) error {
+		keep, err := f(ctx, v)
+		if err != nil {
+			return err
+		}
+		if keep {
+			emit(v)
+		}
+		return nil
+	})
+}
+
+// Batch adds a stage to p that groups values into slices of length size,
+// except possibly the last which holds whatever values remain. Batches are
+// always built by a single worker, so only [WithBuffer] applies.
+//
+// Panics if size is not positive.
+func Batch[V any](p *Pipeline[V], size int, opts ...Option) *Pipeline[[]V] {
+	if size <= 0 {
+		panic("size for Batch must be a positive integer")
+	}
+	config := newStageConfig(opts)
+
+	return &Pipeline[[]V]{
+		start: func(r *runner) <-chan []V {
+			in := p.start(r)
+			out := make(chan []V, config.buffer)
+			r.spawn(func() {
+				defer close(out)
+				batch := make([]V, 0, size)
+				for v := range in {
+					batch = append(batch, v)
+					if len(batch) < size {
+						continue
+					}
+					if !send(r.ctx, out, batch) {
+						return
+					}
+					batch = make([]V, 0, size)
+				}
+				if len(batch) > 0 {
+					send(r.ctx, out, batch)
+				}
+			})
+			return out
+		},
+	}
+}
+
+// Run runs the pipeline, passing each value it produces to sink. Run returns
+// once all stages have stopped, either because the source is exhausted, a
+// stage or sink returned an error, or ctx was cancelled. The returned error
+// joins all errors returned by stages and sink, except those that only
+// report the run's cancellation, or if there are none is ctx's error, if any.
+func (p *Pipeline[V]) Run(ctx context.Context, sink func(V) error) error {
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	r := &runner{ctx: runCtx, cancel: cancel}
+
+	out := p.start(r)
+	for v := range out {
+		if err := sink(v); err != nil {
+			r.fail(err)
+			break
+		}
+	}
+	// stop any stages still running if the sink stopped early
+	cancel()
+	r.wg.Wait()
+
+	if len(r.errs) > 0 {
+		return errors.Join(r.errs...)
+	}
+	return ctx.Err()
+}
+
+// Collect runs the pipeline like [Pipeline.Run], returning all the values
+// it produced.
+func (p *Pipeline[V]) Collect(ctx context.Context) ([]V, error) {
+	var vals []V
+	err := p.Run(ctx, func(v V) error {
+		vals = append(vals, v)
+		return nil
+	})
+	return vals, err
+}
@@ -0,0 +1,96 @@
+package pipeline_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/matthewhughes934/go-itertools/itertools/pipeline"
+)
+
+func Example() {
+	words := slices.Values([]string{"apple", "", "banana", "cherry", "", "damson"})
+
+	nonEmpty := pipeline.Filter(
+		pipeline.From(words),
+		func(_ context.Context, s string) (bool, error) { return s != "", nil },
+	)
+	// e.g. an expensive network request, so run several at once
+	upper := pipeline.Map(
+		nonEmpty,
+		func(_ context.Context, s string) (string, error) { return strings.ToUpper(s), nil },
+		pipeline.WithWorkers(4),
+		pipeline.WithBuffer(4),
+	)
+	batches := pipeline.Batch(upper, 2)
+
+	err := batches.Run(context.Background(), func(batch []string) error {
+		fmt.Println(len(batch))
+		return nil
+	})
+	fmt.Println(err)
+
+	// output:
+	// 2
+	// 2
+	// <nil>
+}
+
+func ExampleMap() {
+	p := pipeline.Map(
+		pipeline.From(slices.Values([]int{1, 2, 3})),
+		func(_ context.Context, x int) (int, error) {
+			if x == 2 {
+				return 0, fmt.Errorf("bad value: %d", x)
+			}
+			return x * 10, nil
+		},
+	)
+
+	vals, err := p.Collect(context.Background())
+	fmt.Println(vals, err)
+
+	// output:
+	// [10] bad value: 2
+}
+
+func ExampleFilter() {
+	p := pipeline.Filter(
+		pipeline.From(slices.Values([]int{1, 2, 3, 4})),
+		func(_ context.Context, x int) (bool, error) { return x%2 == 0, nil },
+	)
+
+	vals, err := p.Collect(context.Background())
+	fmt.Println(vals, err)
+
+	// output:
+	// [2 4] <nil>
+}
+
+func ExampleBatch() {
+	p := pipeline.Batch(pipeline.From(slices.Values([]int{1, 2, 3, 4, 5})), 2)
+
+	vals, err := p.Collect(context.Background())
+	fmt.Println(vals, err)
+
+	// output:
+	// [[1 2] [3 4] [5]] <nil>
+}
+
+func ExamplePipeline_Run() {
+	p := pipeline.From(slices.Values([]string{"a", "b", "c"}))
+
+	err := p.Run(context.Background(), func(s string) error {
+		if s == "b" {
+			return fmt.Errorf("cannot handle %q", s)
+		}
+		fmt.Println(s)
+		return nil
+	})
+	fmt.Println(err)
+
+	// output:
+	// a
+	// cannot handle "b"
+}
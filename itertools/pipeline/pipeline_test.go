@@ -0,0 +1,281 @@
+package pipeline_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthewhughes934/go-itertools/itertools/pipeline"
+)
+
+func double(_ context.Context, x int) (int, error) { return x * 2, nil }
+
+func isEven(_ context.Context, x int) (bool, error) { return x%2 == 0, nil }
+
+// count yields the integers from 0, setting stopped once it is stopped
+func count(stopped *bool) func(func(int) bool) {
+	return func(yield func(int) bool) {
+		defer func() { *stopped = true }()
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestFrom(t *testing.T) {
+	for _, tc := range []struct {
+		vals []int
+	}{
+		{nil},
+		{[]int{1, 2, 3}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got, err := pipeline.From(slices.Values(tc.vals)).Collect(context.Background())
+
+			require.NoError(t, err)
+			require.Equal(t, tc.vals, got)
+		})
+	}
+}
+
+func TestMap(t *testing.T) {
+	for _, tc := range []struct {
+		vals     []int
+		opts     []pipeline.Option
+		expected []string
+	}{
+		{nil, nil, nil},
+		{[]int{1, 2, 3}, nil, []string{"1", "2", "3"}},
+		{[]int{1, 2, 3}, []pipeline.Option{pipeline.WithBuffer(2)}, []string{"1", "2", "3"}},
+		{
+			[]int{1, 2, 3},
+			[]pipeline.Option{pipeline.WithWorkers(3), pipeline.WithBuffer(1)},
+			[]string{"1", "2", "3"},
+		},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			format := func(_ context.Context, x int) (string, error) { return strconv.Itoa(x), nil }
+			p := pipeline.Map(pipeline.From(slices.Values(tc.vals)), format, tc.opts...)
+
+			got, err := p.Collect(context.Background())
+
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.expected, got)
+		})
+	}
+}
+
+func TestMap_error(t *testing.T) {
+	expectedErr := errors.New("bad value")
+	var stopped bool
+	f := func(_ context.Context, x int) (int, error) {
+		if x == 3 {
+			return 0, expectedErr
+		}
+		return x, nil
+	}
+
+	got, err := pipeline.Map(pipeline.From(count(&stopped)), f).Collect(context.Background())
+
+	require.ErrorIs(t, err, expectedErr)
+	require.Equal(t, []int{0, 1, 2}, got)
+	require.True(t, stopped)
+}
+
+func TestMap_multipleErrors(t *testing.T) {
+	errs := map[int]error{1: errors.New("one"), 2: errors.New("two")}
+	// both workers must have a value before either fails
+	var received sync.WaitGroup
+	received.Add(2)
+	f := func(_ context.Context, x int) (int, error) {
+		received.Done()
+		received.Wait()
+		return 0, errs[x]
+	}
+	p := pipeline.Map(pipeline.From(slices.Values([]int{1, 2})), f, pipeline.WithWorkers(2))
+
+	_, err := p.Collect(context.Background())
+
+	require.ErrorIs(t, err, errs[1])
+	require.ErrorIs(t, err, errs[2])
+}
+
+func TestMap_errorCancelsOtherWorkers(t *testing.T) {
+	expectedErr := errors.New("boom")
+	f := func(ctx context.Context, x int) (int, error) {
+		if x == 1 {
+			return 0, expectedErr
+		}
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}
+	p := pipeline.Map(pipeline.From(slices.Values([]int{0, 1})), f, pipeline.WithWorkers(2))
+
+	_, err := p.Collect(context.Background())
+
+	require.EqualError(t, err, "boom")
+	require.NotErrorIs(t, err, context.Canceled)
+}
+
+func TestFilter(t *testing.T) {
+	for _, tc := range []struct {
+		vals     []int
+		opts     []pipeline.Option
+		expected []int
+	}{
+		{nil, nil, nil},
+		{[]int{1, 2, 3, 4}, nil, []int{2, 4}},
+		{[]int{1, 2, 3, 4}, []pipeline.Option{pipeline.WithWorkers(2)}, []int{2, 4}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			p := pipeline.Filter(pipeline.From(slices.Values(tc.vals)), isEven, tc.opts...)
+
+			got, err := p.Collect(context.Background())
+
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.expected, got)
+		})
+	}
+}
+
+func TestFilter_error(t *testing.T) {
+	expectedErr := errors.New("bad value")
+	var stopped bool
+	f := func(context.Context, int) (bool, error) { return false, expectedErr }
+
+	_, err := pipeline.Filter(pipeline.From(count(&stopped)), f).Collect(context.Background())
+
+	require.ErrorIs(t, err, expectedErr)
+	require.True(t, stopped)
+}
+
+func TestBatch(t *testing.T) {
+	for _, tc := range []struct {
+		vals     []int
+		size     int
+		expected [][]int
+	}{
+		{nil, 1, nil},
+		{[]int{1, 2, 3}, 1, [][]int{{1}, {2}, {3}}},
+		{[]int{1, 2, 3}, 2, [][]int{{1, 2}, {3}}},
+		{[]int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{[]int{1, 2, 3}, 5, [][]int{{1, 2, 3}}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			p := pipeline.Batch(pipeline.From(slices.Values(tc.vals)), tc.size)
+
+			got, err := p.Collect(context.Background())
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestBatch_cancelled(t *testing.T) {
+	expectedErr := errors.New("stop")
+	reached := make(chan struct{})
+	source := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			if i == 2 {
+				close(reached)
+			}
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	calls := 0
+	sink := func([]int) error {
+		calls++
+		// wait until the next batch is being sent
+		<-reached
+		return expectedErr
+	}
+
+	err := pipeline.Batch(pipeline.From(source), 1).Run(context.Background(), sink)
+
+	require.ErrorIs(t, err, expectedErr)
+	require.Equal(t, 1, calls)
+}
+
+func TestBatch_invalidSize(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"size for Batch must be a positive integer",
+		func() { pipeline.Batch(pipeline.From(slices.Values([]int{1})), 0) },
+	)
+}
+
+func TestRun_sinkError(t *testing.T) {
+	expectedErr := errors.New("sink failed")
+	var stopped bool
+	var got []int
+	sink := func(x int) error {
+		got = append(got, x)
+		if x == 4 {
+			return expectedErr
+		}
+		return nil
+	}
+
+	err := pipeline.Map(pipeline.From(count(&stopped)), double, pipeline.WithWorkers(2)).
+		Run(context.Background(), sink)
+
+	require.ErrorIs(t, err, expectedErr)
+	require.Contains(t, got, 4)
+	require.True(t, stopped)
+}
+
+func TestRun_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var stopped bool
+	sink := func(x int) error {
+		if x == 2 {
+			cancel()
+		}
+		return nil
+	}
+
+	err := pipeline.Filter(pipeline.From(count(&stopped)), isEven).Run(ctx, sink)
+
+	require.ErrorIs(t, err, context.Canceled)
+	require.True(t, stopped)
+}
+
+func TestRun_reusable(t *testing.T) {
+	p := pipeline.Map(pipeline.From(slices.Values([]int{1, 2, 3})), double)
+
+	first, err := p.Collect(context.Background())
+	require.NoError(t, err)
+	second, err := p.Collect(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, []int{2, 4, 6}, first)
+	require.Equal(t, first, second)
+}
+
+func TestWithWorkers_invalid(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"n for WithWorkers must be a positive integer",
+		func() { pipeline.WithWorkers(0) },
+	)
+}
+
+func TestWithBuffer_invalid(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"n for WithBuffer must be non-negative",
+		func() { pipeline.WithBuffer(-1) },
+	)
+}
@@ -0,0 +1,106 @@
+package itertools
+
+import "iter"
+
+// Peekable wraps a [iter.Seq], converted to a pull-style iterator by
+// [iter.Pull], with the ability to look at the next value without consuming
+// it, e.g. for lookahead when parsing.
+//
+// As with [iter.Pull], it's an error to use a Peekable from multiple
+// goroutines simultaneously, and Stop must be called if the sequence isn't
+// consumed to the end.
+type Peekable[V any] struct {
+	next func() (V, bool)
+	stop func()
+
+	peeked bool
+	v      V
+	ok     bool
+}
+
+// NewPeekable returns a [Peekable] iterating seq.
+func NewPeekable[V any](seq iter.Seq[V]) *Peekable[V] {
+	next, stop := iter.Pull(seq)
+	return &Peekable[V]{next: next, stop: stop}
+}
+
+// Peek returns the next value of the sequence and true, without consuming
+// it, or the zero value and false if the sequence is finished.
+func (p *Peekable[V]) Peek() (V, bool) { //nolint:ireturn
+	if !p.peeked {
+		p.v, p.ok = p.next()
+		p.peeked = true
+	}
+	return p.v, p.ok
+}
+
+// Next consumes and returns the next value of the sequence and true, or the
+// zero value and false if the sequence is finished.
+func (p *Peekable[V]) Next() (V, bool) { //nolint:ireturn
+	if !p.peeked {
+		return p.next()
+	}
+	v, ok := p.v, p.ok
+	var zero V
+	p.v, p.peeked = zero, false
+	return v, ok
+}
+
+// Stop ends the iteration, after which the sequence is finished. It's a
+// no-op if the sequence is already finished.
+func (p *Peekable[V]) Stop() {
+	p.stop()
+	var zero V
+	p.v, p.peeked = zero, false
+}
+
+// Iterator is a pull-style iterator over a [iter.Seq], for when control flow
+// can't be written as a range loop. It's a [Peekable] with helpers for
+// consuming several values at once, and the same restrictions apply: it
+// must not be used from multiple goroutines simultaneously, and Stop must be
+// called if the sequence isn't consumed to the end.
+type Iterator[V any] struct {
+	Peekable[V]
+}
+
+// NewIterator returns an [Iterator] iterating seq.
+func NewIterator[V any](seq iter.Seq[V]) *Iterator[V] {
+	next, stop := iter.Pull(seq)
+	return &Iterator[V]{Peekable[V]{next: next, stop: stop}}
+}
+
+// TakeN consumes and returns the next n values of the sequence, or fewer if
+// the sequence finishes first.
+//
+// TakeN panics if n is negative.
+func (it *Iterator[V]) TakeN(n int) []V {
+	if n < 0 {
+		panic("n for TakeN must be non-negative")
+	}
+	var vals []V
+	for range n {
+		v, ok := it.Next()
+		if !ok {
+			break
+		}
+		vals = append(vals, v)
+	}
+	return vals
+}
+
+// Skip consumes and discards the next n values of the sequence, returning
+// how many were skipped, which is less than n if the sequence finishes
+// first.
+//
+// Skip panics if n is negative.
+func (it *Iterator[V]) Skip(n int) int {
+	if n < 0 {
+		panic("n for Skip must be non-negative")
+	}
+	for i := range n {
+		if _, ok := it.Next(); !ok {
+			return i
+		}
+	}
+	return n
+}
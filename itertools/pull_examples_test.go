@@ -0,0 +1,52 @@
+package itertools_test
+
+import (
+	"fmt"
+	"slices"
+	"unicode"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+)
+
+func ExamplePeekable() {
+	// split input into numbers and operators, using Peek to find where a
+	// number ends without consuming the rune after it
+	p := itertools.NewPeekable(slices.Values([]rune("12+345*6")))
+	defer p.Stop()
+
+	for c, ok := p.Next(); ok; c, ok = p.Next() {
+		token := []rune{c}
+		for unicode.IsDigit(c) {
+			next, ok := p.Peek()
+			if !ok || !unicode.IsDigit(next) {
+				break
+			}
+			token = append(token, next)
+			p.Next()
+		}
+		fmt.Println(string(token))
+	}
+
+	// output:
+	// 12
+	// +
+	// 345
+	// *
+	// 6
+}
+
+func ExampleIterator() {
+	// a stream of length-prefixed records: each count is followed by that
+	// many values
+	it := itertools.NewIterator(slices.Values([]int{2, 10, 20, 0, 3, 1, 2, 3}))
+	defer it.Stop()
+
+	for n, ok := it.Next(); ok; n, ok = it.Next() {
+		fmt.Println(it.TakeN(n))
+	}
+
+	// output:
+	// [10 20]
+	// []
+	// [1 2 3]
+}
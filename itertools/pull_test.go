@@ -0,0 +1,117 @@
+package itertools_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+)
+
+func TestPeekable(t *testing.T) {
+	p := itertools.NewPeekable(itertools.Range(1, 4, 1))
+	defer p.Stop()
+
+	for _, step := range []struct {
+		peek     bool
+		expected int
+		ok       bool
+	}{
+		{true, 1, true},
+		{true, 1, true},
+		{false, 1, true},
+		{false, 2, true},
+		{true, 3, true},
+		{false, 3, true},
+		{true, 0, false},
+		{false, 0, false},
+		{false, 0, false},
+	} {
+		var (
+			v  int
+			ok bool
+		)
+		if step.peek {
+			v, ok = p.Peek()
+		} else {
+			v, ok = p.Next()
+		}
+
+		require.Equal(t, step.expected, v, "%+v", step)
+		require.Equal(t, step.ok, ok, "%+v", step)
+	}
+}
+
+func TestPeekable_stop(t *testing.T) {
+	var (
+		pulled  int
+		stopped bool
+	)
+	p := itertools.NewPeekable(counting(&pulled, &stopped))
+
+	v, ok := p.Peek()
+	require.Equal(t, 0, v)
+	require.True(t, ok)
+	p.Stop()
+
+	require.True(t, stopped)
+	v, ok = p.Peek()
+	require.Zero(t, v)
+	require.False(t, ok)
+	v, ok = p.Next()
+	require.Zero(t, v)
+	require.False(t, ok)
+	require.Equal(t, 1, pulled)
+}
+
+func TestIterator(t *testing.T) {
+	it := itertools.NewIterator(itertools.Range(0, 10, 1))
+	defer it.Stop()
+
+	require.Equal(t, []int{0, 1, 2}, it.TakeN(3))
+	require.Empty(t, it.TakeN(0))
+	require.Equal(t, 2, it.Skip(2))
+	require.Equal(t, 0, it.Skip(0))
+
+	v, ok := it.Peek()
+	require.Equal(t, 5, v)
+	require.True(t, ok)
+	v, ok = it.Next()
+	require.Equal(t, 5, v)
+	require.True(t, ok)
+
+	require.Equal(t, []int{6, 7}, it.TakeN(2))
+	require.Equal(t, 2, it.Skip(5))
+	require.Empty(t, it.TakeN(1))
+	require.Equal(t, 0, it.Skip(1))
+}
+
+func TestIterator_takeNFinished(t *testing.T) {
+	it := itertools.NewIterator(itertools.Range(0, 3, 1))
+	defer it.Stop()
+
+	require.Equal(t, []int{0, 1, 2}, it.TakeN(5))
+}
+
+func TestIterator_stop(t *testing.T) {
+	var (
+		pulled  int
+		stopped bool
+	)
+	it := itertools.NewIterator(counting(&pulled, &stopped))
+
+	require.Equal(t, []int{0, 1}, it.TakeN(2))
+	it.Stop()
+
+	require.True(t, stopped)
+	require.Empty(t, it.TakeN(2))
+	require.Equal(t, 2, pulled)
+}
+
+func TestIterator_negativeN(t *testing.T) {
+	it := itertools.NewIterator(itertools.Range(0, 3, 1))
+	defer it.Stop()
+
+	require.PanicsWithValue(t, "n for TakeN must be non-negative", func() { it.TakeN(-1) })
+	require.PanicsWithValue(t, "n for Skip must be non-negative", func() { it.Skip(-1) })
+}
@@ -0,0 +1,26 @@
+// Package rateiter provides functions for rate limiting sequences with a
+// [rate.Limiter].
+package rateiter
+
+import (
+	"context"
+	"iter"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimit returns a [iter.Seq] that yields the values of seq, waiting on l
+// via [rate.Limiter.Wait] before each value, so iteration shares whatever
+// budget l enforces with any other users of l.
+//
+// Iteration stops if waiting fails, i.e. ctx is cancelled, or its deadline
+// would be exceeded before l allows another value.
+func RateLimit[V any](ctx context.Context, seq iter.Seq[V], l *rate.Limiter) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v := range seq {
+			if l.Wait(ctx) != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
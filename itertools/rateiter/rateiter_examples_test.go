@@ -0,0 +1,27 @@
+package rateiter_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/matthewhughes934/go-itertools/itertools/rateiter"
+)
+
+func ExampleRateLimit() {
+	// at most one request every 10ms, with no bursts
+	limiter := rate.NewLimiter(rate.Every(10*time.Millisecond), 1)
+	requests := slices.Values([]string{"/a", "/b", "/c"})
+
+	for path := range rateiter.RateLimit(context.Background(), requests, limiter) {
+		fmt.Println("GET", path)
+	}
+
+	// output:
+	// GET /a
+	// GET /b
+	// GET /c
+}
@@ -0,0 +1,76 @@
+package rateiter_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+	"github.com/matthewhughes934/go-itertools/itertools/rateiter"
+)
+
+func TestRateLimit(t *testing.T) {
+	for _, tc := range []struct {
+		vals  []int
+		every time.Duration
+	}{
+		{nil, time.Millisecond},
+		{[]int{1}, time.Millisecond},
+		{[]int{1, 2, 3}, time.Millisecond},
+		{[]int{1, 2, 3}, 5 * time.Millisecond},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			l := rate.NewLimiter(rate.Every(tc.every), 1)
+			start := time.Now()
+
+			got := slices.Collect(
+				rateiter.RateLimit(context.Background(), slices.Values(tc.vals), l),
+			)
+
+			require.Equal(t, tc.vals, got)
+			if len(got) > 1 {
+				minElapsed := time.Duration(len(got)-1) * tc.every
+				require.GreaterOrEqual(t, time.Since(start), minElapsed)
+			}
+		})
+	}
+}
+
+func TestRateLimit_earlyStop(t *testing.T) {
+	l := rate.NewLimiter(rate.Inf, 1)
+	seq := rateiter.RateLimit(context.Background(), slices.Values([]int{1, 2, 3}), l)
+
+	require.Equal(t, []int{1, 2}, slices.Collect(itertools.SliceUntil(seq, 2, 1)))
+}
+
+func TestRateLimit_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	l := rate.NewLimiter(rate.Every(time.Hour), 1)
+	var got []int
+
+	for v := range rateiter.RateLimit(ctx, slices.Values([]int{1, 2, 3}), l) {
+		got = append(got, v)
+		cancel()
+	}
+
+	require.Equal(t, []int{1}, got)
+}
+
+func TestRateLimit_sharedLimiter(t *testing.T) {
+	l := rate.NewLimiter(rate.Every(time.Hour), 1)
+	require.True(t, l.Allow())
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// the burst has been used up, and the next token would come after the
+	// deadline
+	got := slices.Collect(rateiter.RateLimit(ctx, slices.Values([]int{1, 2, 3}), l))
+
+	require.Empty(t, got)
+}
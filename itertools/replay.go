@@ -0,0 +1,188 @@
+package itertools
+
+import (
+	"iter"
+	"sync"
+	"sync/atomic"
+)
+
+// Tee returns n independent sequences that each yield all the values of
+// seq, like Python's itertools.tee, so that a single-use seq can be iterated
+// several times. seq itself is iterated at most once: values are buffered
+// until every one of the sequences has yielded them. seq is stopped once it
+// is exhausted or once all n sequences have finished iterating.
+//
+// Each sequence should be iterated at most once, later iterations yield
+// nothing. The sequences may be iterated from different goroutines. Values
+// are buffered for a sequence that hasn't been iterated yet, so if one
+// sequence runs far ahead of the others, or one is never iterated, the
+// buffers can grow to hold all of seq.
+//
+// Panics if n is not positive.
+func Tee[V any](seq iter.Seq[V], n int) []iter.Seq[V] {
+	if n <= 0 {
+		panic("n for Tee must be a positive integer")
+	}
+
+	var (
+		mu        sync.Mutex
+		next      func() (V, bool)
+		stop      func()
+		done      bool
+		buffers   = make([][]V, n)
+		started   = make([]bool, n)
+		finished  = make([]bool, n)
+		remaining = n
+	)
+
+	begin := func(i int) bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if started[i] {
+			return false
+		}
+		started[i] = true
+		return true
+	}
+
+	pull := func(i int) (V, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		var zero V
+		if buf := buffers[i]; len(buf) > 0 {
+			v := buf[0]
+			// drop the reference so the value can be garbage collected
+			buf[0] = zero
+			buffers[i] = buf[1:]
+			return v, true
+		}
+		if done {
+			return zero, false
+		}
+		if next == nil {
+			next, stop = iter.Pull(seq)
+		}
+		v, ok := next()
+		if !ok {
+			done = true
+			stop()
+			return zero, false
+		}
+		for j := range buffers {
+			if j != i && !finished[j] {
+				buffers[j] = append(buffers[j], v)
+			}
+		}
+		return v, true
+	}
+
+	finish := func(i int) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		finished[i] = true
+		buffers[i] = nil
+		remaining--
+		// every sequence pulls before finishing, so stop has been set
+		if remaining == 0 && !done {
+			done = true
+			stop()
+		}
+	}
+
+	seqs := make([]iter.Seq[V], n)
+	for i := range seqs {
+		seqs[i] = func(yield func(V) bool) {
+			if !begin(i) {
+				return
+			}
+			defer finish(i)
+			for {
+				v, ok := pull(i)
+				if !ok || !yield(v) {
+					return
+				}
+			}
+		}
+	}
+	return seqs
+}
+
+// Memoize returns a [iter.Seq] that yields the values of seq, recording
+// them so that later iterations replay the recorded values before continuing
+// to iterate seq from where it left off. As such, seq is iterated at most
+// once however many times the returned sequence is, making a single-use seq
+// safe to iterate several times, e.g. with [slices.Collect].
+//
+// The returned sequence may be iterated from different goroutines. All
+// values of seq produced so far are held in memory, and since iteration may
+// always be resumed, seq is only stopped once it is exhausted.
+func Memoize[V any](seq iter.Seq[V]) iter.Seq[V] {
+	var (
+		mu    sync.Mutex
+		next  func() (V, bool)
+		stop  func()
+		done  bool
+		cache []V
+	)
+
+	get := func(i int) (V, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if i < len(cache) {
+			return cache[i], true
+		}
+		var zero V
+		if done {
+			return zero, false
+		}
+		if next == nil {
+			next, stop = iter.Pull(seq)
+		}
+		v, ok := next()
+		if !ok {
+			done = true
+			stop()
+			return zero, false
+		}
+		cache = append(cache, v)
+		return v, true
+	}
+
+	return func(yield func(V) bool) {
+		for i := 0; ; i++ {
+			v, ok := get(i)
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Once returns a [iter.Seq] that yields the values of seq, but can only be
+// iterated once: iterating it again panics. This catches a single-use seq,
+// e.g. one reading from a channel or [io.Reader], being iterated twice, which
+// would otherwise silently yield nothing or only some of the values.
+func Once[V any](seq iter.Seq[V]) iter.Seq[V] {
+	var used atomic.Bool
+	return func(yield func(V) bool) {
+		if used.Swap(true) {
+			panic("sequence from Once iterated more than once")
+		}
+		seq(yield)
+	}
+}
+
+// OnceOrEmpty is like [Once] but iterating it again yields nothing, rather
+// than panicking.
+func OnceOrEmpty[V any](seq iter.Seq[V]) iter.Seq[V] {
+	var used atomic.Bool
+	return func(yield func(V) bool) {
+		if !used.Swap(true) {
+			seq(yield)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package itertools_test
+
+import (
+	"bufio"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+)
+
+func ExampleTee() {
+	// a single-use source, e.g. reading from a network connection
+	scanner := bufio.NewScanner(strings.NewReader("b\na\nc"))
+	lines := func(yield func(string) bool) {
+		for scanner.Scan() {
+			if !yield(scanner.Text()) {
+				return
+			}
+		}
+	}
+	seqs := itertools.Tee(lines, 2)
+
+	// validate every line first, then process them
+	if !itertools.AllUnique(seqs[0]) {
+		fmt.Println("duplicate lines")
+		return
+	}
+	fmt.Println(itertools.JoinString(seqs[1], ","))
+
+	// output:
+	// b,a,c
+}
+
+func ExampleMemoize() {
+	var calls int
+	expensive := itertools.Map(
+		func(n int) int {
+			calls++
+			return n * n
+		},
+		itertools.Range(1, 4, 1),
+	)
+	squares := itertools.Memoize(expensive)
+
+	fmt.Println(slices.Collect(squares))
+	fmt.Println(slices.Collect(squares))
+	fmt.Println("calls:", calls)
+
+	// output:
+	// [1 4 9]
+	// [1 4 9]
+	// calls: 3
+}
+
+func ExampleOnce() {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+	seq := itertools.Once(itertools.FromChan(ch))
+
+	fmt.Println(slices.Collect(seq))
+	func() {
+		defer func() { fmt.Println("recovered:", recover()) }()
+		// without Once, this would silently print []
+		fmt.Println(slices.Collect(seq))
+	}()
+
+	// output:
+	// [1 2 3]
+	// recovered: sequence from Once iterated more than once
+}
+
+func ExampleOnceOrEmpty() {
+	seq := itertools.OnceOrEmpty(slices.Values([]int{1, 2, 3}))
+
+	fmt.Println(slices.Collect(seq))
+	fmt.Println(slices.Collect(seq))
+
+	// output:
+	// [1 2 3]
+	// []
+}
@@ -0,0 +1,242 @@
+package itertools_test
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+)
+
+// counting returns an infinite sequence of 0, 1, 2, ..., recording in
+// pulled the number of values produced, and in stopped whether it has been
+// stopped
+func counting(pulled *int, stopped *bool) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		defer func() { *stopped = true }()
+		for i := 0; ; i++ {
+			*pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestTee(t *testing.T) {
+	for _, tc := range []struct {
+		vals []int
+		n    int
+	}{
+		{nil, 1},
+		{nil, 3},
+		{[]int{1, 2, 3}, 1},
+		{[]int{1, 2, 3}, 2},
+		{[]int{1, 2, 3}, 3},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			seqs := itertools.Tee(slices.Values(tc.vals), tc.n)
+			require.Len(t, seqs, tc.n)
+
+			for _, seq := range seqs {
+				require.Equal(t, tc.vals, slices.Collect(seq))
+			}
+		})
+	}
+}
+
+func TestTee_interleaved(t *testing.T) {
+	seqs := itertools.Tee(slices.Values([]int{1, 2, 3, 4}), 2)
+	next0, stop0 := iter.Pull(seqs[0])
+	defer stop0()
+	next1, stop1 := iter.Pull(seqs[1])
+	defer stop1()
+	var got0, got1 []int
+
+	for range 2 {
+		v, ok := next0()
+		require.True(t, ok)
+		got0 = append(got0, v)
+	}
+	for range 3 {
+		v, ok := next1()
+		require.True(t, ok)
+		got1 = append(got1, v)
+	}
+
+	require.Equal(t, []int{1, 2}, got0)
+	require.Equal(t, []int{1, 2, 3}, got1)
+}
+
+func TestTee_iteratedOnce(t *testing.T) {
+	seqs := itertools.Tee(slices.Values([]int{1, 2, 3}), 2)
+
+	first := slices.Collect(seqs[0])
+	again := slices.Collect(seqs[0])
+	second := slices.Collect(seqs[1])
+
+	require.Equal(t, []int{1, 2, 3}, first)
+	require.Empty(t, again)
+	require.Equal(t, []int{1, 2, 3}, second)
+}
+
+func TestTee_earlyStop(t *testing.T) {
+	var (
+		pulled  int
+		stopped bool
+	)
+	seqs := itertools.Tee(counting(&pulled, &stopped), 2)
+
+	first := slices.Collect(itertools.SliceUntil(seqs[0], 3, 1))
+	require.False(t, stopped)
+	second := slices.Collect(itertools.SliceUntil(seqs[1], 2, 1))
+
+	require.True(t, stopped)
+	require.Equal(t, []int{0, 1, 2}, first)
+	require.Equal(t, []int{0, 1}, second)
+	require.Equal(t, 3, pulled)
+}
+
+func TestTee_finishedBranchNotBuffered(t *testing.T) {
+	var (
+		pulled  int
+		stopped bool
+	)
+	seqs := itertools.Tee(counting(&pulled, &stopped), 2)
+
+	first := slices.Collect(itertools.SliceUntil(seqs[0], 1, 1))
+	second := slices.Collect(itertools.SliceUntil(seqs[1], 3, 1))
+
+	require.True(t, stopped)
+	require.Equal(t, []int{0}, first)
+	require.Equal(t, []int{0, 1, 2}, second)
+}
+
+func TestTee_concurrent(t *testing.T) {
+	vals := slices.Collect(itertools.Range(0, 100, 1))
+	seqs := itertools.Tee(slices.Values(vals), 4)
+	got := make([][]int, len(seqs))
+
+	var wg sync.WaitGroup
+	for i, seq := range seqs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got[i] = slices.Collect(seq)
+		}()
+	}
+	wg.Wait()
+
+	for _, g := range got {
+		require.Equal(t, vals, g)
+	}
+}
+
+func TestTee_invalidN(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"n for Tee must be a positive integer",
+		func() { itertools.Tee(slices.Values([]int{1}), 0) },
+	)
+}
+
+func TestMemoize(t *testing.T) {
+	for _, tc := range []struct {
+		vals []int
+	}{
+		{nil},
+		{[]int{1}},
+		{[]int{1, 2, 3}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			var iterations int
+			seq := func(yield func(int) bool) {
+				iterations++
+				for _, v := range tc.vals {
+					if !yield(v) {
+						return
+					}
+				}
+			}
+			memoized := itertools.Memoize(seq)
+
+			require.Equal(t, tc.vals, slices.Collect(memoized))
+			require.Equal(t, tc.vals, slices.Collect(memoized))
+			require.Equal(t, 1, iterations)
+		})
+	}
+}
+
+func TestMemoize_resumes(t *testing.T) {
+	var (
+		pulled  int
+		stopped bool
+	)
+	memoized := itertools.Memoize(counting(&pulled, &stopped))
+
+	first := slices.Collect(itertools.SliceUntil(memoized, 2, 1))
+	second := slices.Collect(itertools.SliceUntil(memoized, 4, 1))
+	third := slices.Collect(itertools.SliceUntil(memoized, 3, 1))
+
+	require.Equal(t, []int{0, 1}, first)
+	require.Equal(t, []int{0, 1, 2, 3}, second)
+	require.Equal(t, []int{0, 1, 2}, third)
+	require.Equal(t, 4, pulled)
+	require.False(t, stopped)
+}
+
+func TestMemoize_concurrent(t *testing.T) {
+	vals := slices.Collect(itertools.Range(0, 100, 1))
+	memoized := itertools.Memoize(slices.Values(vals))
+	got := make([][]int, 4)
+
+	var wg sync.WaitGroup
+	for i := range got {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got[i] = slices.Collect(memoized)
+		}()
+	}
+	wg.Wait()
+
+	for _, g := range got {
+		require.Equal(t, vals, g)
+	}
+}
+
+func TestOnce(t *testing.T) {
+	seq := itertools.Once(slices.Values([]int{1, 2, 3}))
+
+	got := slices.Collect(seq)
+
+	require.Equal(t, []int{1, 2, 3}, got)
+	require.PanicsWithValue(
+		t,
+		"sequence from Once iterated more than once",
+		func() { require.Empty(t, slices.Collect(seq)) },
+	)
+}
+
+func TestOnce_earlyStop(t *testing.T) {
+	seq := itertools.Once(slices.Values([]int{1, 2, 3}))
+
+	got := slices.Collect(itertools.SliceUntil(seq, 2, 1))
+
+	require.Equal(t, []int{1, 2}, got)
+	require.Panics(t, func() { require.Empty(t, slices.Collect(seq)) })
+}
+
+func TestOnceOrEmpty(t *testing.T) {
+	seq := itertools.OnceOrEmpty(slices.Values([]int{1, 2, 3}))
+
+	first := slices.Collect(seq)
+	second := slices.Collect(seq)
+
+	require.Equal(t, []int{1, 2, 3}, first)
+	require.Empty(t, second)
+}
@@ -0,0 +1,206 @@
+// Package seqfirst provides the functions of packages itertools and itererr
+// that take a function before the sequence it applies to, with their
+// arguments reordered so the sequence always comes first. This matches the
+// rest of itertools (e.g. [itertools.TakeWhile], [itertools.Associate]) and
+// the pipeline package, so composed pipelines read consistently:
+//
+//	seqfirst.Map(seqfirst.Filter(seq, isEven), square)
+//
+// Each function here behaves exactly as its counterpart, and has the same
+// name, except for [MapErr] and [FilterErr] whose itererr counterparts share
+// their names with those of itertools. Functions that don't take a sequence,
+// such as [itertools.RepeatFunc], aren't included.
+package seqfirst
+
+import (
+	"context"
+	"iter"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+	"github.com/matthewhughes934/go-itertools/itertools/itererr"
+)
+
+// Map is [itertools.Map] with seq first.
+func Map[V1 any, V2 any](seq iter.Seq[V1], mapFunc func(V1) V2) iter.Seq[V2] {
+	return itertools.Map(mapFunc, seq)
+}
+
+// Map2 is [itertools.Map2] with seq first.
+func Map2[K1 comparable, V1 any, K2 comparable, V2 any](
+	seq iter.Seq2[K1, V1],
+	mapFunc func(K1, V1) (K2, V2),
+) iter.Seq2[K2, V2] {
+	return itertools.Map2(mapFunc, seq)
+}
+
+// MapKeys is [itertools.MapKeys] with seq first.
+func MapKeys[K1 comparable, K2 comparable, V any](
+	seq iter.Seq2[K1, V],
+	mapFunc func(K1) K2,
+) iter.Seq2[K2, V] {
+	return itertools.MapKeys(mapFunc, seq)
+}
+
+// MapValues is [itertools.MapValues] with seq first.
+func MapValues[K comparable, V1 any, V2 any](
+	seq iter.Seq2[K, V1],
+	mapFunc func(V1) V2,
+) iter.Seq2[K, V2] {
+	return itertools.MapValues(mapFunc, seq)
+}
+
+// MapCached is [itertools.MapCached] with seq first.
+func MapCached[V1 any, V2 any, K comparable](
+	seq iter.Seq[V1],
+	mapFunc func(V1) V2,
+	keyFunc func(V1) K,
+	maxEntries int,
+) iter.Seq[V2] {
+	return itertools.MapCached(mapFunc, keyFunc, seq, maxEntries)
+}
+
+// Filter is [itertools.Filter] with seq first.
+func Filter[V any](seq iter.Seq[V], filterFunc func(V) bool) iter.Seq[V] {
+	return itertools.Filter(filterFunc, seq)
+}
+
+// Filter2 is [itertools.Filter2] with seq first.
+func Filter2[K comparable, V any](
+	seq iter.Seq2[K, V],
+	filterFunc func(K, V) bool,
+) iter.Seq2[K, V] {
+	return itertools.Filter2(filterFunc, seq)
+}
+
+// AnyFunc is [itertools.AnyFunc] with seq first.
+func AnyFunc[V any](seq iter.Seq[V], checker func(V) bool) bool {
+	return itertools.AnyFunc(checker, seq)
+}
+
+// AnyFunc2 is [itertools.AnyFunc2] with seq first.
+func AnyFunc2[K comparable, V any](seq iter.Seq2[K, V], checker func(K, V) bool) bool {
+	return itertools.AnyFunc2(checker, seq)
+}
+
+// AllFunc is [itertools.AllFunc] with seq first.
+func AllFunc[V any](seq iter.Seq[V], checker func(V) bool) bool {
+	return itertools.AllFunc(checker, seq)
+}
+
+// AllFunc2 is [itertools.AllFunc2] with seq first.
+func AllFunc2[K comparable, V any](seq iter.Seq2[K, V], checker func(K, V) bool) bool {
+	return itertools.AllFunc2(checker, seq)
+}
+
+// FirstFunc is [itertools.FirstFunc] with seq first.
+func FirstFunc[V any](seq iter.Seq[V], checker func(V) bool) (V, bool) { //nolint:ireturn
+	return itertools.FirstFunc(checker, seq)
+}
+
+// FirstFunc2 is [itertools.FirstFunc2] with seq first.
+func FirstFunc2[K comparable, V any]( //nolint:ireturn
+	seq iter.Seq2[K, V],
+	checker func(K, V) bool,
+) (K, V, bool) {
+	return itertools.FirstFunc2(checker, seq)
+}
+
+// LastFunc is [itertools.LastFunc] with seq first.
+func LastFunc[V any](seq iter.Seq[V], checker func(V) bool) (V, bool) { //nolint:ireturn
+	return itertools.LastFunc(checker, seq)
+}
+
+// IndexFunc is [itertools.IndexFunc] with seq first.
+func IndexFunc[V any](seq iter.Seq[V], checker func(V) bool) int {
+	return itertools.IndexFunc(checker, seq)
+}
+
+// LastIndexFunc is [itertools.LastIndexFunc] with seq first.
+func LastIndexFunc[V any](seq iter.Seq[V], checker func(V) bool) int {
+	return itertools.LastIndexFunc(checker, seq)
+}
+
+// Positions is [itertools.Positions] with seq first.
+func Positions[V any](seq iter.Seq[V], checker func(V) bool) iter.Seq[int] {
+	return itertools.Positions(checker, seq)
+}
+
+// AllEqualFunc is [itertools.AllEqualFunc] with seq first.
+func AllEqualFunc[V any](seq iter.Seq[V], eq func(V, V) bool) bool {
+	return itertools.AllEqualFunc(eq, seq)
+}
+
+// AllUniqueFunc is [itertools.AllUniqueFunc] with seq first.
+func AllUniqueFunc[V any, K comparable](seq iter.Seq[V], keyFunc func(V) K) bool {
+	return itertools.AllUniqueFunc(keyFunc, seq)
+}
+
+// ParMap is [itertools.ParMap] with seq before f. As everywhere else, ctx
+// remains the first argument.
+func ParMap[V1, V2 any](
+	ctx context.Context,
+	seq iter.Seq[V1],
+	f func(V1) V2,
+	opts ...itertools.Option,
+) iter.Seq[V2] {
+	return itertools.ParMap(ctx, f, seq, opts...)
+}
+
+// ParMapUnordered is [itertools.ParMapUnordered] with seq before f.
+func ParMapUnordered[V1, V2 any](
+	ctx context.Context,
+	seq iter.Seq[V1],
+	f func(V1) V2,
+	opts ...itertools.Option,
+) iter.Seq[V2] {
+	return itertools.ParMapUnordered(ctx, f, seq, opts...)
+}
+
+// ParFilter is [itertools.ParFilter] with seq before filterFunc.
+func ParFilter[V any](
+	ctx context.Context,
+	seq iter.Seq[V],
+	filterFunc func(V) bool,
+	opts ...itertools.Option,
+) iter.Seq[V] {
+	return itertools.ParFilter(ctx, filterFunc, seq, opts...)
+}
+
+// ParFilterUnordered is [itertools.ParFilterUnordered] with seq before
+// filterFunc.
+func ParFilterUnordered[V any](
+	ctx context.Context,
+	seq iter.Seq[V],
+	filterFunc func(V) bool,
+	opts ...itertools.Option,
+) iter.Seq[V] {
+	return itertools.ParFilterUnordered(ctx, filterFunc, seq, opts...)
+}
+
+// MapErr is [itererr.Map] with seq first.
+func MapErr[V1 any, V2 any](seq iter.Seq2[V1, error], mapFunc func(V1) V2) iter.Seq2[V2, error] {
+	return itererr.Map(mapFunc, seq)
+}
+
+// FilterErr is [itererr.Filter] with seq first.
+func FilterErr[V any](seq iter.Seq2[V, error], filterFunc func(V) bool) iter.Seq2[V, error] {
+	return itererr.Filter(filterFunc, seq)
+}
+
+// TryMap is [itererr.TryMap] with seq first.
+func TryMap[V1 any, V2 any](seq iter.Seq[V1], mapFunc func(V1) (V2, error)) iter.Seq2[V2, error] {
+	return itererr.TryMap(mapFunc, seq)
+}
+
+// TryFilter is [itererr.TryFilter] with seq first.
+func TryFilter[V any](seq iter.Seq[V], filterFunc func(V) (bool, error)) iter.Seq2[V, error] {
+	return itererr.TryFilter(filterFunc, seq)
+}
+
+// TryFilter2 is [itererr.TryFilter2] with seq first.
+func TryFilter2[V any](
+	seq iter.Seq2[V, error],
+	filterFunc func(V) (bool, error),
+) iter.Seq2[V, error] {
+	return itererr.TryFilter2(filterFunc, seq)
+}
@@ -0,0 +1,302 @@
+package seqfirst_test
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+	"github.com/matthewhughes934/go-itertools/itertools/itererr"
+	"github.com/matthewhughes934/go-itertools/itertools/seqfirst"
+)
+
+func Example() {
+	nums := itertools.Range(1, 7, 1)
+
+	// reads left to right: filter, then map
+	labels := seqfirst.Map(
+		seqfirst.Filter(nums, isEven),
+		func(n int) string { return "#" + strconv.Itoa(n) },
+	)
+
+	fmt.Println(slices.Collect(labels))
+
+	// output:
+	// [#2 #4 #6]
+}
+
+func ExampleMap() {
+	fmt.Println(slices.Collect(seqfirst.Map(slices.Values([]string{"a", "b"}), strings.ToUpper)))
+
+	// output:
+	// [A B]
+}
+
+func ExampleMap2() {
+	swap := func(i int, s string) (string, int) { return s, i }
+
+	for k, v := range seqfirst.Map2(slices.All([]string{"a", "b"}), swap) {
+		fmt.Println(k, v)
+	}
+
+	// output:
+	// a 0
+	// b 1
+}
+
+func ExampleMapKeys() {
+	for k, v := range seqfirst.MapKeys(slices.All([]string{"a", "b"}), strconv.Itoa) {
+		fmt.Printf("%q %s\n", k, v)
+	}
+
+	// output:
+	// "0" a
+	// "1" b
+}
+
+func ExampleMapValues() {
+	for k, v := range seqfirst.MapValues(slices.All([]string{"a", "b"}), strings.ToUpper) {
+		fmt.Println(k, v)
+	}
+
+	// output:
+	// 0 A
+	// 1 B
+}
+
+func ExampleMapCached() {
+	lookup := func(s string) int {
+		fmt.Println("looking up", s)
+		return len(s)
+	}
+	identity := func(s string) string { return s }
+	words := slices.Values([]string{"go", "iter", "go"})
+
+	fmt.Println(slices.Collect(seqfirst.MapCached(words, lookup, identity, 0)))
+
+	// output:
+	// looking up go
+	// looking up iter
+	// [2 4 2]
+}
+
+func ExampleFilter() {
+	fmt.Println(slices.Collect(seqfirst.Filter(itertools.Range(0, 7, 1), isEven)))
+
+	// output:
+	// [0 2 4 6]
+}
+
+func ExampleFilter2() {
+	long := func(_ string, n int) bool { return n > 2 }
+	lengths := map[string]int{"go": 2, "iter": 4}
+
+	fmt.Println(maps.Collect(seqfirst.Filter2(maps.All(lengths), long)))
+
+	// output:
+	// map[iter:4]
+}
+
+func ExampleAnyFunc() {
+	fmt.Println(seqfirst.AnyFunc(slices.Values([]int{1, 3, 4}), isEven))
+
+	// output:
+	// true
+}
+
+func ExampleAnyFunc2() {
+	atIndex := func(i int, n int) bool { return i == n }
+
+	fmt.Println(seqfirst.AnyFunc2(slices.All([]int{2, 1, 0}), atIndex))
+
+	// output:
+	// true
+}
+
+func ExampleAllFunc() {
+	fmt.Println(seqfirst.AllFunc(slices.Values([]int{2, 4, 5}), isEven))
+
+	// output:
+	// false
+}
+
+func ExampleAllFunc2() {
+	atIndex := func(i int, n int) bool { return i == n }
+
+	fmt.Println(seqfirst.AllFunc2(slices.All([]int{0, 1, 2}), atIndex))
+
+	// output:
+	// true
+}
+
+func ExampleFirstFunc() {
+	fmt.Println(seqfirst.FirstFunc(slices.Values([]int{1, 2, 3, 4}), isEven))
+
+	// output:
+	// 2 true
+}
+
+func ExampleFirstFunc2() {
+	long := func(_ int, s string) bool { return len(s) > 2 }
+
+	fmt.Println(seqfirst.FirstFunc2(slices.All([]string{"a", "bb", "ccc", "dddd"}), long))
+
+	// output:
+	// 2 ccc true
+}
+
+func ExampleLastFunc() {
+	fmt.Println(seqfirst.LastFunc(slices.Values([]int{1, 2, 3, 4}), isEven))
+
+	// output:
+	// 4 true
+}
+
+func ExampleIndexFunc() {
+	fmt.Println(seqfirst.IndexFunc(slices.Values([]int{1, 2, 3, 4}), isEven))
+
+	// output:
+	// 1
+}
+
+func ExampleLastIndexFunc() {
+	fmt.Println(seqfirst.LastIndexFunc(slices.Values([]int{1, 2, 3, 4}), isEven))
+
+	// output:
+	// 3
+}
+
+func ExamplePositions() {
+	fmt.Println(slices.Collect(seqfirst.Positions(slices.Values([]int{1, 2, 3, 4}), isEven)))
+
+	// output:
+	// [1 3]
+}
+
+func ExampleAllEqualFunc() {
+	fmt.Println(seqfirst.AllEqualFunc(slices.Values([]string{"Go", "GO", "go"}), strings.EqualFold))
+
+	// output:
+	// true
+}
+
+func ExampleAllUniqueFunc() {
+	length := func(s string) int { return len(s) }
+
+	fmt.Println(seqfirst.AllUniqueFunc(slices.Values([]string{"a", "bb", "cc"}), length))
+
+	// output:
+	// false
+}
+
+func ExampleParMap() {
+	square := func(n int) int { return n * n }
+
+	for v := range seqfirst.ParMap(context.Background(), itertools.Range(0, 4, 1), square) {
+		fmt.Println(v)
+	}
+
+	// output:
+	// 0
+	// 1
+	// 4
+	// 9
+}
+
+func ExampleParMapUnordered() {
+	square := func(n int) int { return n * n }
+
+	got := slices.Collect(
+		seqfirst.ParMapUnordered(context.Background(), itertools.Range(0, 4, 1), square),
+	)
+	slices.Sort(got)
+
+	fmt.Println(got)
+
+	// output:
+	// [0 1 4 9]
+}
+
+func ExampleParFilter() {
+	got := seqfirst.ParFilter(context.Background(), itertools.Range(0, 6, 1), isEven)
+
+	fmt.Println(slices.Collect(got))
+
+	// output:
+	// [0 2 4]
+}
+
+func ExampleParFilterUnordered() {
+	got := slices.Collect(
+		seqfirst.ParFilterUnordered(context.Background(), itertools.Range(0, 6, 1), isEven),
+	)
+	slices.Sort(got)
+
+	fmt.Println(got)
+
+	// output:
+	// [0 2 4]
+}
+
+func ExampleMapErr() {
+	lines := itererr.FromSeq(slices.Values([]string{"a", "b"}))
+
+	for v, err := range seqfirst.MapErr(lines, strings.ToUpper) {
+		fmt.Println(v, err)
+	}
+
+	// output:
+	// A <nil>
+	// B <nil>
+}
+
+func ExampleFilterErr() {
+	lines := itererr.FromSeq(slices.Values([]string{"a", "", "b"}))
+	nonEmpty := func(s string) bool { return s != "" }
+
+	fmt.Println(itererr.TryCollect(seqfirst.FilterErr(lines, nonEmpty)))
+
+	// output:
+	// [a b] <nil>
+}
+
+func ExampleTryMap() {
+	for v, err := range seqfirst.TryMap(slices.Values([]string{"1", "x"}), strconv.Atoi) {
+		fmt.Println(v, err)
+	}
+
+	// output:
+	// 1 <nil>
+	// 0 strconv.Atoi: parsing "x": invalid syntax
+}
+
+func ExampleTryFilter() {
+	isEvenNumber := func(s string) (bool, error) {
+		n, err := strconv.Atoi(s)
+		return n%2 == 0, err
+	}
+
+	for v, err := range seqfirst.TryFilter(slices.Values([]string{"1", "2", "x"}), isEvenNumber) {
+		fmt.Printf("%q %v\n", v, err)
+	}
+
+	// output:
+	// "2" <nil>
+	// "" strconv.Atoi: parsing "x": invalid syntax
+}
+
+func ExampleTryFilter2() {
+	isEvenNumber := func(s string) (bool, error) {
+		n, err := strconv.Atoi(s)
+		return n%2 == 0, err
+	}
+	lines := itererr.FromSeq(slices.Values([]string{"1", "2", "4"}))
+
+	fmt.Println(itererr.TryCollect(seqfirst.TryFilter2(lines, isEvenNumber)))
+
+	// output:
+	// [2 4] <nil>
+}
@@ -0,0 +1,195 @@
+package seqfirst_test
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+	"github.com/matthewhughes934/go-itertools/itertools/itererr"
+	"github.com/matthewhughes934/go-itertools/itertools/seqfirst"
+	"github.com/matthewhughes934/go-itertools/itertools/tuple"
+)
+
+func isEven(n int) bool { return n%2 == 0 }
+
+func TestMap(t *testing.T) {
+	got := slices.Collect(seqfirst.Map(slices.Values([]int{1, 2, 3}), strconv.Itoa))
+
+	require.Equal(t, []string{"1", "2", "3"}, got)
+}
+
+func TestMap2(t *testing.T) {
+	swap := func(k int, v string) (string, int) { return v, k }
+
+	got := tuple.CollectPairs(seqfirst.Map2(slices.All([]string{"a", "b"}), swap))
+
+	require.Equal(t, []tuple.Pair[string, int]{tuple.NewPair("a", 0), tuple.NewPair("b", 1)}, got)
+}
+
+func TestMapKeys(t *testing.T) {
+	got := tuple.CollectPairs(seqfirst.MapKeys(slices.All([]string{"a", "b"}), strconv.Itoa))
+
+	require.Equal(
+		t,
+		[]tuple.Pair[string, string]{tuple.NewPair("0", "a"), tuple.NewPair("1", "b")},
+		got,
+	)
+}
+
+func TestMapValues(t *testing.T) {
+	got := tuple.CollectPairs(seqfirst.MapValues(slices.All([]int{3, 4}), strconv.Itoa))
+
+	require.Equal(
+		t,
+		[]tuple.Pair[int, string]{tuple.NewPair(0, "3"), tuple.NewPair(1, "4")},
+		got,
+	)
+}
+
+func TestMapCached(t *testing.T) {
+	calls := 0
+	double := func(n int) int {
+		calls++
+		return n * 2
+	}
+	identity := func(n int) int { return n }
+
+	got := slices.Collect(
+		seqfirst.MapCached(slices.Values([]int{1, 2, 1, 2}), double, identity, 0),
+	)
+
+	require.Equal(t, []int{2, 4, 2, 4}, got)
+	require.Equal(t, 2, calls)
+}
+
+func TestFilter(t *testing.T) {
+	got := slices.Collect(seqfirst.Filter(itertools.Range(0, 5, 1), isEven))
+
+	require.Equal(t, []int{0, 2, 4}, got)
+}
+
+func TestFilter2(t *testing.T) {
+	keyEven := func(k int, _ string) bool { return isEven(k) }
+
+	got := slices.Collect(maps.Values(
+		maps.Collect(seqfirst.Filter2(slices.All([]string{"a", "b", "c"}), keyEven)),
+	))
+	slices.Sort(got)
+
+	require.Equal(t, []string{"a", "c"}, got)
+}
+
+func TestPredicates(t *testing.T) {
+	vals := []int{1, 2, 3, 4}
+	seq := slices.Values(vals)
+	seq2 := slices.All(vals)
+	valEven := func(_ int, v int) bool { return isEven(v) }
+	positive := func(n int) bool { return n > 0 }
+	positive2 := func(_ int, v int) bool { return positive(v) }
+
+	require.True(t, seqfirst.AnyFunc(seq, isEven))
+	require.True(t, seqfirst.AnyFunc2(seq2, valEven))
+	require.False(t, seqfirst.AllFunc(seq, isEven))
+	require.True(t, seqfirst.AllFunc(seq, positive))
+	require.False(t, seqfirst.AllFunc2(seq2, valEven))
+	require.True(t, seqfirst.AllFunc2(seq2, positive2))
+	require.Equal(t, 1, seqfirst.IndexFunc(seq, isEven))
+	require.Equal(t, 3, seqfirst.LastIndexFunc(seq, isEven))
+	require.Equal(t, []int{1, 3}, slices.Collect(seqfirst.Positions(seq, isEven)))
+}
+
+func TestFirstFunc_LastFunc(t *testing.T) {
+	seq := slices.Values([]int{1, 2, 3, 4})
+
+	first, ok := seqfirst.FirstFunc(seq, isEven)
+	require.True(t, ok)
+	require.Equal(t, 2, first)
+
+	last, ok := seqfirst.LastFunc(seq, isEven)
+	require.True(t, ok)
+	require.Equal(t, 4, last)
+
+	k, v, ok := seqfirst.FirstFunc2(slices.All([]int{1, 2, 3}), func(_ int, v int) bool {
+		return isEven(v)
+	})
+	require.True(t, ok)
+	require.Equal(t, 1, k)
+	require.Equal(t, 2, v)
+}
+
+func TestAllEqualFunc_AllUniqueFunc(t *testing.T) {
+	sameParity := func(a, b int) bool { return isEven(a) == isEven(b) }
+	seq := slices.Values([]int{2, 4, 6})
+
+	require.True(t, seqfirst.AllEqualFunc(seq, sameParity))
+	require.False(t, seqfirst.AllUniqueFunc(seq, isEven))
+	require.True(t, seqfirst.AllUniqueFunc(seq, func(n int) int { return n }))
+}
+
+func TestParallel(t *testing.T) {
+	ctx := context.Background()
+	seq := itertools.Range(0, 6, 1)
+	square := func(n int) int { return n * n }
+
+	require.Equal(
+		t,
+		[]int{0, 1, 4, 9, 16, 25},
+		slices.Collect(seqfirst.ParMap(ctx, seq, square, itertools.WithWorkers(2))),
+	)
+	require.ElementsMatch(
+		t,
+		[]int{0, 1, 4, 9, 16, 25},
+		slices.Collect(seqfirst.ParMapUnordered(ctx, seq, square, itertools.WithWorkers(2))),
+	)
+	require.Equal(
+		t,
+		[]int{0, 2, 4},
+		slices.Collect(seqfirst.ParFilter(ctx, seq, isEven, itertools.WithWorkers(2))),
+	)
+	require.ElementsMatch(
+		t,
+		[]int{0, 2, 4},
+		slices.Collect(seqfirst.ParFilterUnordered(ctx, seq, isEven, itertools.WithWorkers(2))),
+	)
+}
+
+func TestFallible(t *testing.T) {
+	errTest := errors.New("test error")
+	checkEven := func(n int) (bool, error) {
+		if n < 0 {
+			return false, errTest
+		}
+		return isEven(n), nil
+	}
+	vals := slices.Values([]int{1, 2, -1, 4})
+
+	mapped, err := itererr.TryCollect(
+		seqfirst.MapErr(itererr.FromSeq(vals), strconv.Itoa),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2", "-1", "4"}, mapped)
+
+	filtered, err := itererr.TryCollect(seqfirst.FilterErr(itererr.FromSeq(vals), isEven))
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 4}, filtered)
+
+	parsed, err := itererr.TryCollect(
+		seqfirst.TryMap(slices.Values([]string{"1", "x"}), strconv.Atoi),
+	)
+	require.Error(t, err)
+	require.Equal(t, []int{1}, parsed)
+
+	even, err := itererr.TryCollectAll(seqfirst.TryFilter(vals, checkEven))
+	require.ErrorIs(t, err, errTest)
+	require.Equal(t, []int{2, 4}, even)
+
+	even, err = itererr.TryCollectAll(seqfirst.TryFilter2(itererr.FromSeq(vals), checkEven))
+	require.ErrorIs(t, err, errTest)
+	require.Equal(t, []int{2, 4}, even)
+}
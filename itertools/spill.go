@@ -0,0 +1,267 @@
+package itertools
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"iter"
+	"os"
+	"sync"
+)
+
+// SpillConfig configures how [MemoizeSpill] and [TeeSpill] buffer values,
+// holding up to MaxInMemory values in memory and writing the rest to a
+// temporary file.
+type SpillConfig[V any] struct {
+	// MaxInMemory is the number of values held in memory before spilling
+	// to disk, it must be non-negative.
+	MaxInMemory int
+	// Dir is the directory for the temporary file, see [os.CreateTemp]. The
+	// default is [os.TempDir].
+	Dir string
+	// Encode and Decode convert values to and from the bytes written to the
+	// temporary file. The default for each is to use [encoding/gob].
+	Encode func(V) ([]byte, error)
+	Decode func([]byte) (V, error)
+}
+
+// MemoizeSpill is like [Memoize] but, per config, values beyond the first
+// config.MaxInMemory are held in a temporary file rather than in memory, so
+// that very long sequences can be replayed. Since writing and reading the
+// file may fail, the returned sequence is fallible, yielding any error (with
+// the zero value) as its final pair.
+//
+// The returned cleanup function stops seq, if it hasn't been exhausted, and
+// removes the temporary file, after which iterating the sequence yields
+// nothing. It must be called once the sequence is no longer needed.
+//
+// Panics if config.MaxInMemory is negative.
+func MemoizeSpill[V any](
+	seq iter.Seq[V],
+	config SpillConfig[V],
+) (iter.Seq2[V, error], func() error) {
+	log := newSpillLog(seq, config, "MemoizeSpill")
+	return log.iterate, log.close
+}
+
+// TeeSpill is like [Tee] but, per config, values beyond the first
+// config.MaxInMemory are held in a temporary file rather than in memory, so
+// that one sequence may run far ahead of the others. Unlike [Tee], values
+// are kept until cleanup, even once every sequence has yielded them. As
+// with [MemoizeSpill], the returned sequences are fallible, and the returned
+// cleanup function must be called once they are no longer needed.
+//
+// Panics if n is not positive, or config.MaxInMemory is negative.
+func TeeSpill[V any](
+	seq iter.Seq[V],
+	n int,
+	config SpillConfig[V],
+) ([]iter.Seq2[V, error], func() error) {
+	if n <= 0 {
+		panic("n for TeeSpill must be a positive integer")
+	}
+	log := newSpillLog(seq, config, "TeeSpill")
+
+	var (
+		mu        sync.Mutex
+		started   = make([]bool, n)
+		remaining = n
+	)
+	begin := func(i int) bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if started[i] {
+			return false
+		}
+		started[i] = true
+		return true
+	}
+	finish := func() {
+		mu.Lock()
+		defer mu.Unlock()
+
+		remaining--
+		if remaining == 0 {
+			log.stopSource()
+		}
+	}
+
+	seqs := make([]iter.Seq2[V, error], n)
+	for i := range seqs {
+		seqs[i] = func(yield func(V, error) bool) {
+			if !begin(i) {
+				return
+			}
+			defer finish()
+			log.iterate(yield)
+		}
+	}
+	return seqs, log.close
+}
+
+// spillLog records the values of a sequence as they're pulled, the first in
+// memory and the rest in a temporary file, so they can be read back by index
+type spillLog[V any] struct {
+	mu     sync.Mutex
+	seq    iter.Seq[V]
+	config SpillConfig[V]
+
+	next   func() (V, bool)
+	stop   func()
+	done   bool
+	closed bool
+	err    error
+
+	mem  []V
+	file *os.File
+	// the offset in file of each spilled value, followed by the end of the
+	// last one
+	offsets []int64
+	size    int64
+}
+
+func newSpillLog[V any](seq iter.Seq[V], config SpillConfig[V], caller string) *spillLog[V] {
+	if config.MaxInMemory < 0 {
+		panic("MaxInMemory for " + caller + " must be non-negative")
+	}
+	if config.Encode == nil {
+		config.Encode = gobEncode[V]
+	}
+	if config.Decode == nil {
+		config.Decode = gobDecode[V]
+	}
+	return &spillLog[V]{seq: seq, config: config}
+}
+
+func (l *spillLog[V]) iterate(yield func(V, error) bool) {
+	for i := 0; ; i++ {
+		v, ok, err := l.get(i)
+		if err != nil {
+			yield(v, err)
+			return
+		}
+		if !ok || !yield(v, nil) {
+			return
+		}
+	}
+}
+
+// get returns the value at index i, pulling from the sequence if it hasn't
+// been reached yet
+func (l *spillLog[V]) get(i int) (V, bool, error) { //nolint:ireturn
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var zero V
+	switch {
+	case l.closed:
+		return zero, false, nil
+	case i < len(l.mem):
+		return l.mem[i], true, nil
+	case i-len(l.mem) < len(l.offsets)-1:
+		v, err := l.read(i - len(l.mem))
+		if err != nil {
+			return zero, false, err
+		}
+		return v, true, nil
+	case l.err != nil:
+		return zero, false, l.err
+	case l.done:
+		return zero, false, nil
+	}
+
+	if l.next == nil {
+		l.next, l.stop = iter.Pull(l.seq)
+	}
+	v, ok := l.next()
+	if !ok {
+		l.done = true
+		l.stop()
+		return zero, false, nil
+	}
+	if len(l.mem) < l.config.MaxInMemory {
+		l.mem = append(l.mem, v)
+		return v, true, nil
+	}
+	if err := l.write(v); err != nil {
+		l.err = err
+		return zero, false, err
+	}
+	return v, true, nil
+}
+
+func (l *spillLog[V]) write(v V) error {
+	if l.file == nil {
+		f, err := os.CreateTemp(l.config.Dir, "itertools-spill-*")
+		if err != nil {
+			return err
+		}
+		l.file = f
+	}
+	b, err := l.config.Encode(v)
+	if err == nil {
+		_, err = l.file.Write(b)
+	}
+	if err != nil {
+		return err
+	}
+	if len(l.offsets) == 0 {
+		l.offsets = append(l.offsets, 0)
+	}
+	l.size += int64(len(b))
+	l.offsets = append(l.offsets, l.size)
+	return nil
+}
+
+func (l *spillLog[V]) read(j int) (V, error) { //nolint:ireturn
+	var v V
+	b := make([]byte, l.offsets[j+1]-l.offsets[j])
+	_, err := l.file.ReadAt(b, l.offsets[j])
+	if err == nil {
+		v, err = l.config.Decode(b)
+	}
+	return v, err
+}
+
+func (l *spillLog[V]) stopSource() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// nothing was pulled if cleanup happened first
+	if l.next != nil && !l.done {
+		l.done = true
+		l.stop()
+	}
+}
+
+func (l *spillLog[V]) close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return nil
+	}
+	l.closed = true
+	if l.next != nil && !l.done {
+		l.done = true
+		l.stop()
+	}
+	l.mem = nil
+	if l.file == nil {
+		return nil
+	}
+	return errors.Join(l.file.Close(), os.Remove(l.file.Name()))
+}
+
+func gobEncode[V any](v V) ([]byte, error) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(v)
+	return buf.Bytes(), err
+}
+
+func gobDecode[V any](b []byte) (V, error) { //nolint:ireturn
+	var v V
+	err := gob.NewDecoder(bytes.NewReader(b)).Decode(&v)
+	return v, err
+}
@@ -0,0 +1,67 @@
+package itertools_test
+
+import (
+	"fmt"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+)
+
+func ExampleMemoizeSpill() {
+	// keep the first 100 values in memory, and write the rest to disk
+	memoized, cleanup := itertools.MemoizeSpill(
+		itertools.Range(0, 1000, 1),
+		itertools.SpillConfig[int]{MaxInMemory: 100},
+	)
+	defer func() {
+		if err := cleanup(); err != nil {
+			fmt.Println("error:", err)
+		}
+	}()
+
+	for range 2 {
+		sum := 0
+		for n, err := range memoized {
+			if err != nil {
+				fmt.Println("error:", err)
+				return
+			}
+			sum += n
+		}
+		fmt.Println(sum)
+	}
+
+	// output:
+	// 499500
+	// 499500
+}
+
+func ExampleTeeSpill() {
+	seqs, cleanup := itertools.TeeSpill(
+		itertools.Range(0, 1000, 1),
+		2,
+		itertools.SpillConfig[int]{MaxInMemory: 100},
+	)
+	defer func() {
+		if err := cleanup(); err != nil {
+			fmt.Println("error:", err)
+		}
+	}()
+
+	// the first sequence runs to the end before the second starts, so most
+	// values are buffered on disk
+	for _, seq := range seqs {
+		count := 0
+		for _, err := range seq {
+			if err != nil {
+				fmt.Println("error:", err)
+				return
+			}
+			count++
+		}
+		fmt.Println(count)
+	}
+
+	// output:
+	// 1000
+	// 1000
+}
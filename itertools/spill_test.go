@@ -0,0 +1,323 @@
+package itertools_test
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+	"github.com/matthewhughes934/go-itertools/itertools/tuple"
+)
+
+var errEncoding = errors.New("encoding error")
+
+func requireDirEmpty(t *testing.T, dir string) {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func collectNoError[V any](t *testing.T, seq iter.Seq2[V, error]) []V {
+	t.Helper()
+
+	var vals []V
+	for v, err := range seq {
+		require.NoError(t, err)
+		vals = append(vals, v)
+	}
+	return vals
+}
+
+func TestMemoizeSpill(t *testing.T) {
+	for _, tc := range []struct {
+		vals        []int
+		maxInMemory int
+	}{
+		{nil, 0},
+		{[]int{1, 2, 3}, 0},
+		{[]int{1, 2, 3}, 2},
+		{[]int{1, 2, 3}, 3},
+		{[]int{1, 2, 3}, 10},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			dir := t.TempDir()
+			var iterations int
+			seq := func(yield func(int) bool) {
+				iterations++
+				for _, v := range tc.vals {
+					if !yield(v) {
+						return
+					}
+				}
+			}
+			memoized, cleanup := itertools.MemoizeSpill(
+				seq,
+				itertools.SpillConfig[int]{MaxInMemory: tc.maxInMemory, Dir: dir},
+			)
+
+			require.Equal(t, tc.vals, collectNoError(t, memoized))
+			require.Equal(t, tc.vals, collectNoError(t, memoized))
+			require.Equal(t, 1, iterations)
+
+			require.NoError(t, cleanup())
+			requireDirEmpty(t, dir)
+			require.Empty(t, collectNoError(t, memoized))
+			require.NoError(t, cleanup())
+		})
+	}
+}
+
+func TestMemoizeSpill_spillsToDisk(t *testing.T) {
+	dir := t.TempDir()
+	memoized, cleanup := itertools.MemoizeSpill(
+		itertools.Range(0, 5, 1),
+		itertools.SpillConfig[int]{MaxInMemory: 2, Dir: dir},
+	)
+	defer func() { require.NoError(t, cleanup()) }()
+
+	first := collectNoError(t, itertools.SliceUntil2(memoized, 2, 1))
+	requireDirEmpty(t, dir)
+	second := collectNoError(t, memoized)
+
+	require.Equal(t, []int{0, 1}, first)
+	require.Equal(t, []int{0, 1, 2, 3, 4}, second)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func TestMemoizeSpill_customEncoding(t *testing.T) {
+	var encoded, decoded []string
+	config := itertools.SpillConfig[int]{
+		Dir: t.TempDir(),
+		Encode: func(n int) ([]byte, error) {
+			s := strconv.Itoa(n)
+			encoded = append(encoded, s)
+			return []byte(s), nil
+		},
+		Decode: func(b []byte) (int, error) {
+			decoded = append(decoded, string(b))
+			return strconv.Atoi(string(b))
+		},
+	}
+	memoized, cleanup := itertools.MemoizeSpill(slices.Values([]int{1, 22, 333}), config)
+	defer func() { require.NoError(t, cleanup()) }()
+
+	require.Equal(t, []int{1, 22, 333}, collectNoError(t, memoized))
+	require.Equal(t, []int{1, 22, 333}, collectNoError(t, memoized))
+	require.Equal(t, []string{"1", "22", "333"}, encoded)
+	require.Equal(t, []string{"1", "22", "333"}, decoded)
+}
+
+func TestMemoizeSpill_resumes(t *testing.T) {
+	var (
+		pulled  int
+		stopped bool
+	)
+	memoized, cleanup := itertools.MemoizeSpill(
+		counting(&pulled, &stopped),
+		itertools.SpillConfig[int]{MaxInMemory: 1, Dir: t.TempDir()},
+	)
+
+	first := collectNoError(t, itertools.SliceUntil2(memoized, 2, 1))
+	second := collectNoError(t, itertools.SliceUntil2(memoized, 3, 1))
+	require.False(t, stopped)
+	require.NoError(t, cleanup())
+
+	require.True(t, stopped)
+	require.Equal(t, []int{0, 1}, first)
+	require.Equal(t, []int{0, 1, 2}, second)
+	require.Equal(t, 3, pulled)
+}
+
+func TestMemoizeSpill_errors(t *testing.T) {
+	failEncode := func(n int) ([]byte, error) {
+		if n == 2 {
+			return nil, errEncoding
+		}
+		return []byte{byte(n)}, nil
+	}
+	failDecode := func(b []byte) (int, error) {
+		if b[0] == 2 {
+			return 2, errEncoding
+		}
+		return int(b[0]), nil
+	}
+	okEncode := func(n int) ([]byte, error) { return []byte{byte(n)}, nil }
+	okDecode := func(b []byte) (int, error) { return int(b[0]), nil }
+
+	for _, tc := range []struct {
+		name     string
+		config   itertools.SpillConfig[int]
+		expected error
+	}{
+		{
+			"create error",
+			itertools.SpillConfig[int]{Dir: filepath.Join(t.TempDir(), "missing")},
+			os.ErrNotExist,
+		},
+		{
+			"encode error",
+			itertools.SpillConfig[int]{Encode: failEncode, Decode: okDecode},
+			errEncoding,
+		},
+		{
+			"decode error",
+			itertools.SpillConfig[int]{Encode: okEncode, Decode: failDecode},
+			errEncoding,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.config.Dir == "" {
+				tc.config.Dir = t.TempDir()
+			}
+			memoized, cleanup := itertools.MemoizeSpill(slices.Values([]int{1, 2, 3}), tc.config)
+			defer func() { require.NoError(t, cleanup()) }()
+
+			// values are read back from disk on the second iteration
+			tuple.CollectPairs(memoized)
+			got := tuple.CollectPairs(memoized)
+
+			require.NotEmpty(t, got)
+			last := got[len(got)-1]
+			require.ErrorIs(t, last.Second, tc.expected)
+			require.Zero(t, last.First)
+		})
+	}
+}
+
+func TestMemoizeSpill_concurrent(t *testing.T) {
+	vals := slices.Collect(itertools.Range(0, 100, 1))
+	memoized, cleanup := itertools.MemoizeSpill(
+		slices.Values(vals),
+		itertools.SpillConfig[int]{MaxInMemory: 10, Dir: t.TempDir()},
+	)
+	defer func() { require.NoError(t, cleanup()) }()
+	got := make([][]int, 4)
+
+	var wg sync.WaitGroup
+	for i := range got {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v, err := range memoized {
+				if err != nil {
+					return
+				}
+				got[i] = append(got[i], v)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, g := range got {
+		require.Equal(t, vals, g)
+	}
+}
+
+func TestMemoizeSpill_negativeMaxInMemory(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"MaxInMemory for MemoizeSpill must be non-negative",
+		func() {
+			itertools.MemoizeSpill(
+				slices.Values([]int{1}),
+				itertools.SpillConfig[int]{MaxInMemory: -1},
+			)
+		},
+	)
+}
+
+func TestTeeSpill(t *testing.T) {
+	for _, tc := range []struct {
+		vals        []int
+		n           int
+		maxInMemory int
+	}{
+		{nil, 1, 0},
+		{[]int{1, 2, 3}, 1, 0},
+		{[]int{1, 2, 3}, 2, 0},
+		{[]int{1, 2, 3}, 3, 2},
+		{[]int{1, 2, 3}, 2, 10},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			dir := t.TempDir()
+			seqs, cleanup := itertools.TeeSpill(
+				slices.Values(tc.vals),
+				tc.n,
+				itertools.SpillConfig[int]{MaxInMemory: tc.maxInMemory, Dir: dir},
+			)
+			require.Len(t, seqs, tc.n)
+
+			for _, seq := range seqs {
+				require.Equal(t, tc.vals, collectNoError(t, seq))
+				require.Empty(t, collectNoError(t, seq))
+			}
+
+			require.NoError(t, cleanup())
+			requireDirEmpty(t, dir)
+		})
+	}
+}
+
+func TestTeeSpill_earlyStop(t *testing.T) {
+	var (
+		pulled  int
+		stopped bool
+	)
+	seqs, cleanup := itertools.TeeSpill(
+		counting(&pulled, &stopped),
+		2,
+		itertools.SpillConfig[int]{MaxInMemory: 1, Dir: t.TempDir()},
+	)
+	defer func() { require.NoError(t, cleanup()) }()
+
+	first := collectNoError(t, itertools.SliceUntil2(seqs[0], 3, 1))
+	require.False(t, stopped)
+	second := collectNoError(t, itertools.SliceUntil2(seqs[1], 2, 1))
+
+	require.True(t, stopped)
+	require.Equal(t, []int{0, 1, 2}, first)
+	require.Equal(t, []int{0, 1}, second)
+	require.Equal(t, 3, pulled)
+}
+
+func TestTeeSpill_afterCleanup(t *testing.T) {
+	seqs, cleanup := itertools.TeeSpill(
+		slices.Values([]int{1, 2}),
+		1,
+		itertools.SpillConfig[int]{Dir: t.TempDir()},
+	)
+	require.NoError(t, cleanup())
+
+	require.Empty(t, collectNoError(t, seqs[0]))
+}
+
+func TestTeeSpill_panics(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"n for TeeSpill must be a positive integer",
+		func() { itertools.TeeSpill(slices.Values([]int{1}), 0, itertools.SpillConfig[int]{}) },
+	)
+	require.PanicsWithValue(
+		t,
+		"MaxInMemory for TeeSpill must be non-negative",
+		func() {
+			itertools.TeeSpill(
+				slices.Values([]int{1}),
+				1,
+				itertools.SpillConfig[int]{MaxInMemory: -1},
+			)
+		},
+	)
+}
@@ -0,0 +1,36 @@
+// Package sqliter provides functions for iterating over the results of
+// database/sql queries.
+package sqliter
+
+import (
+	"database/sql"
+	"errors"
+	"iter"
+)
+
+// Rows returns a fallible [iter.Seq2] that yields the result of calling
+// scan on each row of rows, e.g. to call [sql.Rows.Scan] into a struct.
+//
+// Rows takes ownership of rows: it is closed once iteration ends, for
+// whatever reason, and so the sequence can only be iterated once. If scan
+// fails, or an error occurs while iterating or closing rows, the error is
+// yielded as the final pair.
+func Rows[T any](rows *sql.Rows, scan func(*sql.Rows) (T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for rows.Next() {
+			v, err := scan(rows)
+			if err != nil {
+				yield(zero, errors.Join(err, rows.Close()))
+				return
+			}
+			if !yield(v, nil) {
+				rows.Close() //nolint:errcheck // the caller stopped, nowhere to report it
+				return
+			}
+		}
+		if err := errors.Join(rows.Err(), rows.Close()); err != nil {
+			yield(zero, err)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package sqliter_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/matthewhughes934/go-itertools/itertools/sqliter"
+)
+
+func ExampleRows() {
+	// a stand-in for a real database, whose queries return 1, 2, 3 and 4
+	db := sql.OpenDB(fakeDB{&fakeRows{vals: []int64{1, 2, 3, 4}}})
+	defer db.Close()
+
+	rows, err := db.QueryContext(context.Background(), "SELECT n FROM numbers")
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+
+	scan := func(rows *sql.Rows) (int, error) {
+		var n int
+		err := rows.Scan(&n)
+		return n, err
+	}
+	for n, err := range sqliter.Rows(rows, scan) {
+		if err != nil {
+			fmt.Println("error:", err)
+			return
+		}
+		fmt.Println(n)
+	}
+
+	// output:
+	// 1
+	// 2
+	// 3
+	// 4
+}
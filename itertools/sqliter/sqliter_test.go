@@ -0,0 +1,163 @@
+package sqliter_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+	"github.com/matthewhughes934/go-itertools/itertools/sqliter"
+	"github.com/matthewhughes934/go-itertools/itertools/tuple"
+)
+
+var (
+	errTest  = errors.New("test error")
+	errClose = errors.New("close error")
+)
+
+// fakeRows is a result set of a single integer column, which reports
+// nextErr once its values are exhausted, and closeErr when closed
+type fakeRows struct {
+	vals     []int64
+	nextErr  error
+	closeErr error
+	closed   bool
+}
+
+func (r *fakeRows) Columns() []string { return []string{"n"} }
+
+func (r *fakeRows) Close() error {
+	r.closed = true
+	return r.closeErr
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.vals) == 0 {
+		if r.nextErr != nil {
+			return r.nextErr
+		}
+		return io.EOF
+	}
+	dest[0], r.vals = r.vals[0], r.vals[1:]
+	return nil
+}
+
+// fakeDB implements just enough of a driver to run a query returning rows
+type fakeDB struct{ rows *fakeRows }
+
+func (db fakeDB) Connect(context.Context) (driver.Conn, error) { return db, nil }
+func (db fakeDB) Driver() driver.Driver                        { return nil }
+func (db fakeDB) Prepare(string) (driver.Stmt, error)          { return db, nil }
+func (db fakeDB) Close() error                                 { return nil }
+func (db fakeDB) Begin() (driver.Tx, error)                    { return nil, errors.ErrUnsupported }
+func (db fakeDB) NumInput() int                                { return 0 }
+
+func (db fakeDB) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.ErrUnsupported
+}
+
+func (db fakeDB) Query([]driver.Value) (driver.Rows, error) { return db.rows, nil }
+
+func query(t *testing.T, rows *fakeRows) *sql.Rows {
+	t.Helper()
+
+	db := sql.OpenDB(fakeDB{rows})
+	t.Cleanup(func() { require.NoError(t, db.Close()) })
+	//nolint:rowserrcheck,sqlclosecheck // checked and closed by sqliter.Rows
+	res, err := db.Query("SELECT n")
+	require.NoError(t, err)
+	return res
+}
+
+func scanInt(rows *sql.Rows) (int, error) {
+	var n int
+	err := rows.Scan(&n)
+	return n, err
+}
+
+func TestRows(t *testing.T) {
+	for _, tc := range []struct {
+		vals []int64
+	}{
+		{nil},
+		{[]int64{1}},
+		{[]int64{1, 2, 3}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			rows := &fakeRows{vals: tc.vals}
+			var expected []tuple.Pair[int, error]
+			for _, v := range tc.vals {
+				expected = append(expected, tuple.NewPair[int, error](int(v), nil))
+			}
+
+			got := tuple.CollectPairs(sqliter.Rows(query(t, rows), scanInt))
+
+			require.Equal(t, expected, got)
+			require.True(t, rows.closed)
+		})
+	}
+}
+
+func TestRows_errors(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		rows     *fakeRows
+		scan     func(*sql.Rows) (int, error)
+		expected []error
+	}{
+		{
+			"scan error",
+			&fakeRows{vals: []int64{1, 2}},
+			func(rows *sql.Rows) (int, error) {
+				n, err := scanInt(rows)
+				if n == 2 {
+					return 0, errTest
+				}
+				return n, err
+			},
+			[]error{errTest},
+		},
+		{
+			"scan and close error",
+			&fakeRows{vals: []int64{1, 2}, closeErr: errClose},
+			func(*sql.Rows) (int, error) { return 0, errTest },
+			[]error{errTest, errClose},
+		},
+		{"next error", &fakeRows{vals: []int64{1}, nextErr: errTest}, scanInt, []error{errTest}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tuple.CollectPairs(sqliter.Rows(query(t, tc.rows), tc.scan))
+
+			require.NotEmpty(t, got)
+			last := got[len(got)-1]
+			require.Zero(t, last.First)
+			for _, err := range tc.expected {
+				require.ErrorIs(t, last.Second, err)
+			}
+			require.True(t, tc.rows.closed)
+		})
+	}
+}
+
+func TestRows_earlyStop(t *testing.T) {
+	rows := &fakeRows{vals: []int64{1, 2, 3}}
+	seq := sqliter.Rows(query(t, rows), scanInt)
+
+	got := tuple.CollectPairs(itertools.SliceUntil2(seq, 2, 1))
+
+	require.Equal(
+		t,
+		[]tuple.Pair[int, error]{
+			tuple.NewPair[int, error](1, nil),
+			tuple.NewPair[int, error](2, nil),
+		},
+		got,
+	)
+	require.True(t, rows.closed)
+}
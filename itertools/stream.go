@@ -0,0 +1,87 @@
+package itertools
+
+import (
+	"iter"
+	"slices"
+)
+
+// Stream is a [iter.Seq] with methods wrapping some of the functions of
+// this package, so that a pipeline can be written as a chain of calls read
+// from top to bottom, rather than as nested calls read from the inside out.
+// As a Stream is a sequence, it can be ranged over directly.
+//
+// Since methods can't have type parameters, [Stream.Map] can't change the
+// type of values. For that, use [Map] on the underlying sequence, e.g.
+//
+//	NewStream(Map(strconv.Itoa, s.Seq()))
+type Stream[V any] iter.Seq[V]
+
+// NewStream returns a [Stream] of the values of seq.
+func NewStream[V any](seq iter.Seq[V]) Stream[V] {
+	return Stream[V](seq)
+}
+
+// Seq returns the underlying sequence of s.
+func (s Stream[V]) Seq() iter.Seq[V] {
+	return iter.Seq[V](s)
+}
+
+// Map is equivalent to [Map] with s.
+func (s Stream[V]) Map(mapFunc func(V) V) Stream[V] {
+	return NewStream(Map(mapFunc, s.Seq()))
+}
+
+// Filter is equivalent to [Filter] with s.
+func (s Stream[V]) Filter(filterFunc func(V) bool) Stream[V] {
+	return NewStream(Filter(filterFunc, s.Seq()))
+}
+
+// Take returns a [Stream] of the first n values of s, or all of them if
+// there are fewer than n.
+//
+// Take panics if n is negative.
+func (s Stream[V]) Take(n int) Stream[V] {
+	if n < 0 {
+		panic("n for Take must be non-negative")
+	}
+	return NewStream(SliceUntil(s.Seq(), n, 1))
+}
+
+// Skip returns a [Stream] of the values of s after the first n.
+//
+// Skip panics if n is negative.
+func (s Stream[V]) Skip(n int) Stream[V] {
+	if n < 0 {
+		panic("n for Skip must be non-negative")
+	}
+	return NewStream(SliceFrom(s.Seq(), n, 1))
+}
+
+// TakeWhile is equivalent to [TakeWhile] with s.
+func (s Stream[V]) TakeWhile(predicate func(V) bool) Stream[V] {
+	return NewStream(TakeWhile(s.Seq(), predicate))
+}
+
+// DropWhile is equivalent to [DropWhile] with s.
+func (s Stream[V]) DropWhile(predicate func(V) bool) Stream[V] {
+	return NewStream(DropWhile(s.Seq(), predicate))
+}
+
+// Chunk returns a sequence of the values of s in slices of length size,
+// except possibly the last which holds whatever values remain. Each slice is
+// new and may be retained by the caller. The result is not a [Stream], since
+// a generic type can't have a method returning itself instantiated with a
+// different type argument; wrap it with [NewStream] to continue the chain.
+//
+// Chunk panics if size is not positive.
+func (s Stream[V]) Chunk(size int) iter.Seq[[]V] {
+	if size <= 0 {
+		panic("size for Chunk must be a positive integer")
+	}
+	return chunks(s.Seq(), size)
+}
+
+// Collect collects the values of s into a new slice.
+func (s Stream[V]) Collect() []V {
+	return slices.Collect(s.Seq())
+}
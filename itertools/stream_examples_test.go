@@ -0,0 +1,35 @@
+package itertools_test
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+)
+
+func ExampleStream() {
+	evenSquares := itertools.NewStream(itertools.RangeFrom(1, 1)).
+		Filter(isEven).
+		Map(func(n int) int { return n * n }).
+		Take(4)
+
+	for chunk := range evenSquares.Chunk(2) {
+		fmt.Println(chunk)
+	}
+
+	// output:
+	// [4 16]
+	// [36 64]
+}
+
+func ExampleStream_changingType() {
+	nums := itertools.NewStream(itertools.Range(1, 4, 1)).Map(func(n int) int { return n * 10 })
+
+	labels := itertools.NewStream(itertools.Map(strconv.Itoa, nums.Seq())).
+		Map(func(s string) string { return "#" + s })
+
+	fmt.Println(labels.Collect())
+
+	// output:
+	// [#10 #20 #30]
+}
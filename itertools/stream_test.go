@@ -0,0 +1,105 @@
+package itertools_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+)
+
+func TestStream(t *testing.T) {
+	isSmall := func(n int) bool { return n < 5 }
+	square := func(n int) int { return n * n }
+
+	for _, tc := range []struct {
+		name     string
+		stream   itertools.Stream[int]
+		expected []int
+	}{
+		{"empty", itertools.NewStream(slices.Values([]int(nil))), nil},
+		{"identity", itertools.NewStream(itertools.Range(0, 3, 1)), []int{0, 1, 2}},
+		{"map", itertools.NewStream(itertools.Range(0, 4, 1)).Map(square), []int{0, 1, 4, 9}},
+		{"filter", itertools.NewStream(itertools.Range(0, 6, 1)).Filter(isEven), []int{0, 2, 4}},
+		{"take", itertools.NewStream(itertools.RangeFrom(0, 1)).Take(3), []int{0, 1, 2}},
+		{"take zero", itertools.NewStream(itertools.RangeFrom(0, 1)).Take(0), nil},
+		{"take more", itertools.NewStream(itertools.Range(0, 2, 1)).Take(3), []int{0, 1}},
+		{"skip", itertools.NewStream(itertools.Range(0, 5, 1)).Skip(3), []int{3, 4}},
+		{"skip more", itertools.NewStream(itertools.Range(0, 2, 1)).Skip(3), nil},
+		{
+			"take while",
+			itertools.NewStream(itertools.RangeFrom(0, 2)).TakeWhile(isSmall),
+			[]int{0, 2, 4},
+		},
+		{
+			"drop while",
+			itertools.NewStream(itertools.Range(0, 8, 1)).DropWhile(isSmall),
+			[]int{5, 6, 7},
+		},
+		{
+			"chained",
+			itertools.NewStream(itertools.RangeFrom(0, 1)).
+				Filter(isEven).
+				Map(square).
+				Skip(1).
+				Take(3),
+			[]int{4, 16, 36},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.stream.Collect())
+			require.Equal(t, tc.expected, slices.Collect(tc.stream.Seq()))
+		})
+	}
+}
+
+func TestStream_Chunk(t *testing.T) {
+	for _, tc := range []struct {
+		vals     []int
+		size     int
+		expected [][]int
+	}{
+		{nil, 2, nil},
+		{[]int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{[]int{1, 2, 3}, 2, [][]int{{1, 2}, {3}}},
+		{[]int{1, 2, 3}, 5, [][]int{{1, 2, 3}}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := slices.Collect(itertools.NewStream(slices.Values(tc.vals)).Chunk(tc.size))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestStream_Chunk_earlyStop(t *testing.T) {
+	got := itertools.NewStream(itertools.NewStream(itertools.RangeFrom(0, 1)).Chunk(2)).
+		Take(2).
+		Collect()
+
+	require.Equal(t, [][]int{{0, 1}, {2, 3}}, got)
+}
+
+func TestStream_range(t *testing.T) {
+	var got []int
+
+	for v := range itertools.NewStream(itertools.Range(0, 3, 1)) {
+		got = append(got, v)
+	}
+
+	require.Equal(t, []int{0, 1, 2}, got)
+}
+
+func TestStream_panics(t *testing.T) {
+	s := itertools.NewStream(slices.Values([]int{1}))
+
+	require.PanicsWithValue(t, "n for Take must be non-negative", func() { s.Take(-1) })
+	require.PanicsWithValue(t, "n for Skip must be non-negative", func() { s.Skip(-1) })
+	require.PanicsWithValue(
+		t,
+		"size for Chunk must be a positive integer",
+		func() { s.Chunk(0) },
+	)
+}
@@ -0,0 +1,339 @@
+package itertools
+
+import (
+	"context"
+	"iter"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// RangeTime is like [Range] but for times: it yields times step duration
+// apart from start until end, not including end.
+//
+// RangeTime panics if step is 0.
+func RangeTime(start time.Time, end time.Time, step time.Duration) iter.Seq[time.Time] {
+	if step == 0 {
+		panic("step for RangeTime must be non-zero")
+	}
+	return func(yield func(time.Time) bool) {
+		for t := start; step > 0 && t.Before(end) || step < 0 && t.After(end); t = t.Add(step) {
+			if !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// RangeDates is like [RangeTime] but steps by calendar months and days, as
+// per [time.Time.AddDate], rather than a fixed duration. Each value is
+// computed by adding a multiple of the step to start, so e.g. stepping by a
+// month from January 31st yields March 2nd or 3rd (the normalised form of
+// February 31st), then March 31st, rather than drifting to the 2nd or 3rd of
+// every following month.
+//
+// RangeDates panics if stepping by months and days doesn't move forwards or
+// backwards from start.
+func RangeDates(start time.Time, end time.Time, months int, days int) iter.Seq[time.Time] {
+	direction := start.AddDate(0, months, days).Compare(start)
+	if direction == 0 {
+		panic("step for RangeDates must be non-zero")
+	}
+	return func(yield func(time.Time) bool) {
+		for i := 0; ; i++ {
+			t := start.AddDate(0, i*months, i*days)
+			if t.Compare(end) != -direction || !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// Schedule returns a [iter.Seq] that yields the trigger times of a schedule
+// after from: next(from), next(next(from)) and so on. next should return the
+// first trigger time after the given time, as e.g. a cron expression parser
+// would, or the zero [time.Time] if there are no more triggers, in which case
+// the sequence ends.
+//
+// Schedule only computes times, it doesn't wait for them, so it can be
+// combined with e.g. [TakeWhile] to plan ahead.
+func Schedule(next func(time.Time) time.Time, from time.Time) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		for t := next(from); !t.IsZero(); t = next(t) {
+			if !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// Every returns a function to use with [Schedule] that triggers every d
+// after the previous trigger.
+func Every(d time.Duration) func(time.Time) time.Time {
+	return func(t time.Time) time.Time { return t.Add(d) }
+}
+
+// EveryAligned returns a function to use with [Schedule] that triggers on
+// every multiple of d since the zero time, e.g. on the hour every hour. See
+// [time.Time.Truncate] for how multiples are calculated.
+func EveryAligned(d time.Duration) func(time.Time) time.Time {
+	return func(t time.Time) time.Time { return t.Truncate(d).Add(d) }
+}
+
+// Throttle returns a [iter.Seq] that yields the values of seq, waiting
+// where needed so that consecutive values are yielded at least minInterval
+// apart.
+//
+// Throttle panics if minInterval is negative.
+func Throttle[V any](seq iter.Seq[V], minInterval time.Duration) iter.Seq[V] {
+	if minInterval < 0 {
+		panic("minInterval for Throttle must be non-negative")
+	}
+	return func(yield func(V) bool) {
+		var last time.Time
+		for v := range seq {
+			if !last.IsZero() {
+				time.Sleep(minInterval - time.Since(last))
+			}
+			last = time.Now()
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Debounce returns a [iter.Seq] that yields a value of seq only once d has
+// passed without seq producing another, so of a burst of values arriving
+// less than d apart only the last is yielded. If seq is exhausted while a
+// value is waiting, it is yielded immediately. Iteration stops once seq is
+// exhausted or ctx is cancelled.
+//
+// seq is iterated in a separate goroutine, which has exited on return unless
+// ctx was cancelled. Then Debounce returns without waiting for seq to produce
+// a value, and the goroutine stops seq in the background once it does.
+//
+// Debounce panics if d is negative.
+func Debounce[V any](ctx context.Context, seq iter.Seq[V], d time.Duration) iter.Seq[V] {
+	if d < 0 {
+		panic("d for Debounce must be non-negative")
+	}
+	return func(yield func(V) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		defer waitUnlessCancelled(ctx, cancel, &wg)
+
+		values := goSend(ctx, &wg, seq)
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		timer.Stop()
+
+		var (
+			pending    V
+			hasPending bool
+		)
+		for {
+			select {
+			case v, ok := <-values:
+				if !ok {
+					if hasPending {
+						yield(pending)
+					}
+					return
+				}
+				pending, hasPending = v, true
+				timer.Reset(d)
+			case <-timer.C:
+				hasPending = false
+				if !yield(pending) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// SampleEvery returns a [iter.Seq] that, every d, yields the most recent
+// value produced by seq, if any were produced since the previous yield. If
+// seq is exhausted while a value is waiting, it is yielded immediately.
+// Iteration stops once seq is exhausted or ctx is cancelled.
+//
+// seq is iterated in a separate goroutine, which has exited on return unless
+// ctx was cancelled, in which case it is left to stop seq in the background,
+// as with [Debounce].
+//
+// SampleEvery panics if d is not positive.
+func SampleEvery[V any](ctx context.Context, seq iter.Seq[V], d time.Duration) iter.Seq[V] {
+	if d <= 0 {
+		panic("d for SampleEvery must be positive")
+	}
+	return func(yield func(V) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		defer waitUnlessCancelled(ctx, cancel, &wg)
+
+		values := goSend(ctx, &wg, seq)
+		ticker := time.NewTicker(d)
+		defer ticker.Stop()
+
+		var (
+			latest    V
+			hasLatest bool
+		)
+		for {
+			select {
+			case v, ok := <-values:
+				if !ok {
+					if hasLatest {
+						yield(latest)
+					}
+					return
+				}
+				latest, hasLatest = v, true
+			case <-ticker.C:
+				if hasLatest {
+					hasLatest = false
+					if !yield(latest) {
+						return
+					}
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// goSend sends each value of seq on the returned channel from a new goroutine
+// tracked by wg, closing the channel once seq is exhausted or ctx is
+// cancelled.
+func goSend[V any](ctx context.Context, wg *sync.WaitGroup, seq iter.Seq[V]) <-chan V {
+	values := make(chan V)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(values)
+		for v := range seq {
+			select {
+			case values <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return values
+}
+
+// Delay returns a [iter.Seq] that yields the values of seq, waiting d before
+// yielding each one. Iteration stops if ctx is cancelled, including while
+// waiting.
+//
+// Delay panics if d is negative.
+func Delay[V any](ctx context.Context, seq iter.Seq[V], d time.Duration) iter.Seq[V] {
+	if d < 0 {
+		panic("d for Delay must be non-negative")
+	}
+	return func(yield func(V) bool) {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		timer.Stop()
+
+		for v := range seq {
+			timer.Reset(d)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				return
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// TimeLimited returns a [iter.Seq] that yields the values of seq until d
+// has passed since iteration started. The deadline is checked as each value
+// is produced, so a slow seq may run past it, but no value produced after
+// the deadline is yielded. Like Python's more_itertools.time_limited.
+//
+// TimeLimited panics if d is negative.
+func TimeLimited[V any](seq iter.Seq[V], d time.Duration) iter.Seq[V] {
+	if d < 0 {
+		panic("d for TimeLimited must be non-negative")
+	}
+	return func(yield func(V) bool) {
+		start := time.Now()
+		for v := range seq {
+			if time.Since(start) >= d || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Tick returns a [iter.Seq] that yields the time every d, via a
+// [time.Ticker], until ctx is cancelled. As with [time.Ticker], ticks are
+// dropped rather than queued if the caller is slow to ask for the next one.
+//
+// Tick panics if d is not positive.
+func Tick(ctx context.Context, d time.Duration) iter.Seq[time.Time] {
+	if d <= 0 {
+		panic("d for Tick must be positive")
+	}
+	return func(yield func(time.Time) bool) {
+		ticker := time.NewTicker(d)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case t := <-ticker.C:
+				if !yield(t) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// Backoff returns a [iter.Seq] of durations for exponential backoff: it
+// starts at base, multiplying by factor for each subsequent duration, up to
+// maxDelay. The sequence is infinite, so use e.g. [SliceUntil] to limit the
+// number of attempts.
+//
+// If jitter is non-zero, each duration d is randomised to within jitter * d
+// of its value, e.g. a jitter of 0.1 gives a duration between 0.9 * d and
+// 1.1 * d, to avoid many clients retrying in lockstep.
+//
+// Backoff panics if base is not positive, maxDelay is less than base, factor
+// is less than 1, or jitter is not between 0 and 1.
+func Backoff(base, maxDelay time.Duration, factor, jitter float64) iter.Seq[time.Duration] {
+	switch {
+	case base <= 0:
+		panic("base for Backoff must be positive")
+	case maxDelay < base:
+		panic("maxDelay for Backoff must be at least base")
+	case factor < 1:
+		panic("factor for Backoff must be at least 1")
+	case jitter < 0 || jitter > 1:
+		panic("jitter for Backoff must be between 0 and 1")
+	}
+	return func(yield func(time.Duration) bool) {
+		d := float64(base)
+		for {
+			d = min(d, float64(maxDelay))
+			jittered := d
+			if jitter > 0 {
+				jittered *= 1 + jitter*(2*rand.Float64()-1) //nolint:gosec // not for security
+			}
+			if !yield(time.Duration(jittered)) {
+				return
+			}
+			d *= factor
+		}
+	}
+}
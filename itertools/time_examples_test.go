@@ -0,0 +1,192 @@
+package itertools_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+)
+
+func ExampleRangeTime() {
+	start := time.Date(2024, time.January, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+
+	for t := range itertools.RangeTime(start, end, 30*time.Minute) {
+		fmt.Println(t.Format(time.Kitchen))
+	}
+
+	// output:
+	// 9:00AM
+	// 9:30AM
+	// 10:00AM
+	// 10:30AM
+}
+
+func ExampleRangeDates() {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	// quarterly report buckets
+	for t := range itertools.RangeDates(start, end, 3, 0) {
+		fmt.Println(t.Format(time.DateOnly))
+	}
+
+	// output:
+	// 2024-01-01
+	// 2024-04-01
+	// 2024-07-01
+	// 2024-10-01
+}
+
+func ExampleSchedule() {
+	from := time.Date(2024, time.January, 1, 9, 10, 0, 0, time.UTC)
+	schedule := itertools.Schedule(itertools.EveryAligned(15*time.Minute), from)
+
+	for t := range itertools.SliceUntil(schedule, 3, 1) {
+		fmt.Println(t.Format(time.Kitchen))
+	}
+
+	// output:
+	// 9:15AM
+	// 9:30AM
+	// 9:45AM
+}
+
+func ExampleEvery() {
+	from := time.Date(2024, time.January, 1, 9, 10, 0, 0, time.UTC)
+	schedule := itertools.Schedule(itertools.Every(15*time.Minute), from)
+
+	for t := range itertools.SliceUntil(schedule, 3, 1) {
+		fmt.Println(t.Format(time.Kitchen))
+	}
+
+	// output:
+	// 9:25AM
+	// 9:40AM
+	// 9:55AM
+}
+
+func ExampleThrottle() {
+	requests := slices.Values([]string{"/a", "/b", "/c"})
+
+	start := time.Now()
+	for path := range itertools.Throttle(requests, 10*time.Millisecond) {
+		fmt.Println("GET", path)
+	}
+	fmt.Println(time.Since(start) >= 20*time.Millisecond)
+
+	// output:
+	// GET /a
+	// GET /b
+	// GET /c
+	// true
+}
+
+func ExampleDebounce() {
+	// e.g. a file watcher reporting a burst of writes, then a single write
+	events := func(yield func(string) bool) {
+		for _, event := range []string{"write 1", "write 2", "write 3"} {
+			if !yield(event) {
+				return
+			}
+		}
+		time.Sleep(100 * time.Millisecond)
+		yield("write 4")
+	}
+
+	for event := range itertools.Debounce(context.Background(), events, 20*time.Millisecond) {
+		fmt.Println(event)
+	}
+
+	// output:
+	// write 3
+	// write 4
+}
+
+func ExampleSampleEvery() {
+	// e.g. a sensor reporting readings much faster than we want to log them
+	readings := func(yield func(int) bool) {
+		for i := range 10 {
+			if !yield(i) {
+				return
+			}
+		}
+		time.Sleep(75 * time.Millisecond)
+		for i := 10; i < 20; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	sampled := itertools.SampleEvery(context.Background(), readings, 50*time.Millisecond)
+	for reading := range sampled {
+		fmt.Println(reading)
+	}
+
+	// output:
+	// 9
+	// 19
+}
+
+func ExampleDelay() {
+	// e.g. replaying recorded events at a gentle pace
+	events := slices.Values([]string{"connect", "send", "disconnect"})
+
+	for event := range itertools.Delay(context.Background(), events, 10*time.Millisecond) {
+		fmt.Println(event)
+	}
+
+	// output:
+	// connect
+	// send
+	// disconnect
+}
+
+func ExampleTimeLimited() {
+	// e.g. retrying for at most 50ms, however many attempts that takes
+	attempts := itertools.Delay(
+		context.Background(),
+		itertools.RangeFrom(1, 1),
+		20*time.Millisecond,
+	)
+
+	for attempt := range itertools.TimeLimited(attempts, 50*time.Millisecond) {
+		fmt.Println("attempt", attempt)
+	}
+
+	// output:
+	// attempt 1
+	// attempt 2
+}
+
+func ExampleTick() {
+	ticks := itertools.Tick(context.Background(), 10*time.Millisecond)
+
+	for i := range itertools.Enumerate(itertools.SliceUntil(ticks, 3, 1), 1) {
+		fmt.Println("tick", i)
+	}
+
+	// output:
+	// tick 1
+	// tick 2
+	// tick 3
+}
+
+func ExampleBackoff() {
+	delays := itertools.Backoff(100*time.Millisecond, time.Second, 2, 0)
+
+	for delay := range itertools.SliceUntil(delays, 6, 1) {
+		fmt.Println(delay)
+	}
+
+	// output:
+	// 100ms
+	// 200ms
+	// 400ms
+	// 800ms
+	// 1s
+	// 1s
+}
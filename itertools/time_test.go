@@ -0,0 +1,550 @@
+package itertools_test
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthewhughes934/go-itertools/itertools"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestRangeTime(t *testing.T) {
+	start := date(2024, time.January, 1)
+
+	for _, tc := range []struct {
+		end      time.Time
+		step     time.Duration
+		expected []time.Time
+	}{
+		{start, time.Hour, nil},
+		{start.Add(-time.Hour), time.Hour, nil},
+		{
+			start.Add(3 * time.Hour),
+			time.Hour,
+			[]time.Time{start, start.Add(time.Hour), start.Add(2 * time.Hour)},
+		},
+		{
+			start.Add(-3 * time.Hour),
+			-2 * time.Hour,
+			[]time.Time{start, start.Add(-2 * time.Hour)},
+		},
+	} {
+		t.Run(fmt.Sprintf("%s %s", tc.end, tc.step), func(t *testing.T) {
+			got := slices.Collect(itertools.RangeTime(start, tc.end, tc.step))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestRangeTime_earlyStop(t *testing.T) {
+	start := date(2024, time.January, 1)
+	seq := itertools.RangeTime(start, start.Add(time.Hour), time.Minute)
+
+	got := slices.Collect(itertools.SliceUntil(seq, 2, 1))
+
+	require.Equal(t, []time.Time{start, start.Add(time.Minute)}, got)
+}
+
+func TestRangeTime_panicsOnZeroStep(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"step for RangeTime must be non-zero",
+		func() { itertools.RangeTime(time.Time{}, time.Time{}, 0) },
+	)
+}
+
+func TestRangeDates(t *testing.T) {
+	for _, tc := range []struct {
+		start    time.Time
+		end      time.Time
+		months   int
+		days     int
+		expected []time.Time
+	}{
+		{
+			date(2024, time.January, 1),
+			date(2024, time.January, 1),
+			0,
+			1,
+			nil,
+		},
+		{
+			date(2024, time.January, 31),
+			date(2024, time.May, 2),
+			1,
+			0,
+			[]time.Time{
+				date(2024, time.January, 31),
+				date(2024, time.March, 2),
+				date(2024, time.March, 31),
+				date(2024, time.May, 1),
+			},
+		},
+		{
+			date(2024, time.March, 1),
+			date(2024, time.January, 1),
+			-1,
+			-1,
+			[]time.Time{
+				date(2024, time.March, 1),
+				date(2024, time.January, 31),
+			},
+		},
+	} {
+		t.Run(fmt.Sprintf("%s %d %d", tc.start, tc.months, tc.days), func(t *testing.T) {
+			got := slices.Collect(itertools.RangeDates(tc.start, tc.end, tc.months, tc.days))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestRangeDates_earlyStop(t *testing.T) {
+	start := date(2024, time.January, 1)
+	seq := itertools.RangeDates(start, date(2025, time.January, 1), 0, 7)
+
+	got := slices.Collect(itertools.SliceUntil(seq, 2, 1))
+
+	require.Equal(t, []time.Time{start, date(2024, time.January, 8)}, got)
+}
+
+func TestRangeDates_panicsOnZeroStep(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"step for RangeDates must be non-zero",
+		func() { itertools.RangeDates(time.Time{}, time.Time{}, 1, -31) },
+	)
+}
+
+func TestSchedule(t *testing.T) {
+	start := date(2024, time.January, 1)
+	// triggers on the first three days after start
+	next := func(t time.Time) time.Time {
+		if t.Before(start.AddDate(0, 0, 3)) {
+			return t.AddDate(0, 0, 1)
+		}
+		return time.Time{}
+	}
+	expected := []time.Time{
+		date(2024, time.January, 2),
+		date(2024, time.January, 3),
+		date(2024, time.January, 4),
+	}
+
+	got := slices.Collect(itertools.Schedule(next, start))
+
+	require.Equal(t, expected, got)
+}
+
+func TestSchedule_earlyStop(t *testing.T) {
+	start := date(2024, time.January, 1)
+	schedule := itertools.Schedule(itertools.Every(time.Hour), start)
+
+	got := slices.Collect(itertools.SliceUntil(schedule, 2, 1))
+
+	require.Equal(t, []time.Time{start.Add(time.Hour), start.Add(2 * time.Hour)}, got)
+}
+
+func TestThrottle(t *testing.T) {
+	for _, tc := range []struct {
+		vals        []int
+		minInterval time.Duration
+	}{
+		{nil, time.Millisecond},
+		{[]int{1}, time.Hour},
+		{[]int{1, 2, 3}, 0},
+		{[]int{1, 2, 3}, 5 * time.Millisecond},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			start := time.Now()
+
+			got := slices.Collect(itertools.Throttle(slices.Values(tc.vals), tc.minInterval))
+
+			require.Equal(t, tc.vals, got)
+			if len(got) > 1 {
+				minElapsed := time.Duration(len(got)-1) * tc.minInterval
+				require.GreaterOrEqual(t, time.Since(start), minElapsed)
+			}
+		})
+	}
+}
+
+func TestThrottle_earlyStop(t *testing.T) {
+	seq := itertools.Throttle(slices.Values([]int{1, 2, 3}), time.Millisecond)
+
+	got := slices.Collect(itertools.SliceUntil(seq, 2, 1))
+
+	require.Equal(t, []int{1, 2}, got)
+}
+
+func TestThrottle_negativeInterval(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"minInterval for Throttle must be non-negative",
+		func() { itertools.Throttle(slices.Values([]int{1}), -time.Second) },
+	)
+}
+
+// bursts yields the values of each burst immediately, pausing for gap
+// between bursts
+func bursts(gap time.Duration, bursts ...[]int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i, burst := range bursts {
+			if i > 0 {
+				time.Sleep(gap)
+			}
+			for _, v := range burst {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func TestDebounce(t *testing.T) {
+	for _, tc := range []struct {
+		bursts   [][]int
+		expected []int
+	}{
+		{nil, nil},
+		{[][]int{{1}}, []int{1}},
+		{[][]int{{1, 2, 3}}, []int{3}},
+		{[][]int{{1, 2, 3}, {4, 5}}, []int{3, 5}},
+		{[][]int{{1}, {2}, {3}}, []int{1, 2, 3}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			seq := bursts(100*time.Millisecond, tc.bursts...)
+
+			got := slices.Collect(
+				itertools.Debounce(context.Background(), seq, 20*time.Millisecond),
+			)
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestDebounce_earlyStop(t *testing.T) {
+	seq := bursts(50*time.Millisecond, []int{1}, []int{2}, []int{3})
+
+	got := slices.Collect(
+		itertools.SliceUntil(itertools.Debounce(context.Background(), seq, time.Millisecond), 2, 1),
+	)
+
+	require.Equal(t, []int{1, 2}, got)
+}
+
+func TestDebounce_cancelled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	// produces one value, then blocks until cancelled
+	blocking := func(yield func(int) bool) {
+		if yield(1) {
+			<-ctx.Done()
+		}
+	}
+
+	got := slices.Collect(itertools.Debounce(ctx, blocking, time.Hour))
+
+	require.Empty(t, got)
+}
+
+func TestDebounce_cancelledWhileBlocked(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	seq, unblock, stopped := blockedSeq()
+
+	got := slices.Collect(itertools.Debounce(ctx, itertools.Keys(seq), time.Millisecond))
+
+	require.Empty(t, got)
+	unblock()
+	<-stopped
+}
+
+func TestDebounce_negativeDuration(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"d for Debounce must be non-negative",
+		func() { itertools.Debounce(context.Background(), slices.Values([]int{1}), -time.Second) },
+	)
+}
+
+func TestSampleEvery(t *testing.T) {
+	for _, tc := range []struct {
+		bursts   [][]int
+		d        time.Duration
+		expected []int
+	}{
+		{nil, time.Hour, nil},
+		{[][]int{{1, 2, 3}}, time.Hour, []int{3}},
+		{[][]int{{1, 2, 3}, {4, 5}}, 30 * time.Millisecond, []int{3, 5}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			// the gap falls between ticks, so each burst is sampled once
+			seq := bursts(75*time.Millisecond, tc.bursts...)
+
+			got := slices.Collect(itertools.SampleEvery(context.Background(), seq, tc.d))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestSampleEvery_earlyStop(t *testing.T) {
+	seq := itertools.SampleEvery(context.Background(), itertools.RangeFrom(0, 1), time.Millisecond)
+
+	got := slices.Collect(itertools.SliceUntil(seq, 2, 1))
+
+	require.Len(t, got, 2)
+	require.Less(t, got[0], got[1])
+}
+
+func TestSampleEvery_cancelled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	// produces one value, then blocks until cancelled
+	blocking := func(yield func(int) bool) {
+		if yield(1) {
+			<-ctx.Done()
+		}
+	}
+
+	got := slices.Collect(itertools.SampleEvery(ctx, blocking, time.Hour))
+
+	require.Empty(t, got)
+}
+
+func TestSampleEvery_cancelledWhileBlocked(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	seq, unblock, stopped := blockedSeq()
+
+	got := slices.Collect(itertools.SampleEvery(ctx, itertools.Keys(seq), time.Millisecond))
+
+	require.Empty(t, got)
+	unblock()
+	<-stopped
+}
+
+func TestSampleEvery_nonPositiveDuration(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"d for SampleEvery must be positive",
+		func() { itertools.SampleEvery(context.Background(), slices.Values([]int{1}), 0) },
+	)
+}
+
+func TestDelay(t *testing.T) {
+	for _, tc := range []struct {
+		vals []int
+		d    time.Duration
+	}{
+		{nil, time.Millisecond},
+		{[]int{1}, time.Millisecond},
+		{[]int{1, 2, 3}, 0},
+		{[]int{1, 2, 3}, 5 * time.Millisecond},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			start := time.Now()
+
+			got := slices.Collect(
+				itertools.Delay(context.Background(), slices.Values(tc.vals), tc.d),
+			)
+
+			require.Equal(t, tc.vals, got)
+			require.GreaterOrEqual(t, time.Since(start), time.Duration(len(got))*tc.d)
+		})
+	}
+}
+
+func TestDelay_earlyStop(t *testing.T) {
+	seq := itertools.Delay(context.Background(), itertools.RangeFrom(0, 1), time.Millisecond)
+
+	got := slices.Collect(itertools.SliceUntil(seq, 2, 1))
+
+	require.Equal(t, []int{0, 1}, got)
+}
+
+func TestDelay_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var got []int
+
+	for v := range itertools.Delay(ctx, slices.Values([]int{1, 2, 3}), 10*time.Millisecond) {
+		got = append(got, v)
+		cancel()
+	}
+
+	require.Equal(t, []int{1}, got)
+}
+
+func TestDelay_negativeDuration(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"d for Delay must be non-negative",
+		func() { itertools.Delay(context.Background(), slices.Values([]int{1}), -time.Second) },
+	)
+}
+
+func TestTimeLimited(t *testing.T) {
+	for _, tc := range []struct {
+		vals     []int
+		d        time.Duration
+		expected []int
+	}{
+		{nil, time.Hour, nil},
+		{[]int{1, 2, 3}, time.Hour, []int{1, 2, 3}},
+		{[]int{1, 2, 3}, 0, nil},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := slices.Collect(itertools.TimeLimited(slices.Values(tc.vals), tc.d))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestTimeLimited_deadlinePassed(t *testing.T) {
+	seq := bursts(50*time.Millisecond, []int{1, 2}, []int{3, 4})
+
+	got := slices.Collect(itertools.TimeLimited(seq, 25*time.Millisecond))
+
+	require.Equal(t, []int{1, 2}, got)
+}
+
+func TestTimeLimited_earlyStop(t *testing.T) {
+	seq := itertools.TimeLimited(itertools.RangeFrom(0, 1), time.Hour)
+
+	got := slices.Collect(itertools.SliceUntil(seq, 2, 1))
+
+	require.Equal(t, []int{0, 1}, got)
+}
+
+func TestTimeLimited_negativeDuration(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"d for TimeLimited must be non-negative",
+		func() { itertools.TimeLimited(slices.Values([]int{1}), -time.Second) },
+	)
+}
+
+func TestTick(t *testing.T) {
+	start := time.Now()
+
+	got := slices.Collect(
+		itertools.SliceUntil(itertools.Tick(context.Background(), 5*time.Millisecond), 3, 1),
+	)
+
+	require.Len(t, got, 3)
+	require.GreaterOrEqual(t, got[0].Sub(start), 5*time.Millisecond)
+	for i := 1; i < len(got); i++ {
+		require.True(t, got[i].After(got[i-1]))
+	}
+}
+
+func TestTick_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var got []time.Time
+
+	for tick := range itertools.Tick(ctx, 10*time.Millisecond) {
+		got = append(got, tick)
+		cancel()
+	}
+
+	require.Len(t, got, 1)
+}
+
+func TestTick_nonPositiveDuration(t *testing.T) {
+	require.PanicsWithValue(
+		t,
+		"d for Tick must be positive",
+		func() { itertools.Tick(context.Background(), 0) },
+	)
+}
+
+func TestBackoff(t *testing.T) {
+	for _, tc := range []struct {
+		base     time.Duration
+		maxDelay time.Duration
+		factor   float64
+		expected []time.Duration
+	}{
+		{time.Second, time.Second, 2, []time.Duration{time.Second, time.Second, time.Second}},
+		{time.Second, time.Hour, 1, []time.Duration{time.Second, time.Second, time.Second}},
+		{
+			time.Second,
+			time.Hour,
+			2,
+			[]time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second},
+		},
+		{
+			time.Second,
+			5 * time.Second,
+			2,
+			[]time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second},
+		},
+		{
+			100 * time.Millisecond,
+			time.Second,
+			1.5,
+			[]time.Duration{100 * time.Millisecond, 150 * time.Millisecond, 225 * time.Millisecond},
+		},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			seq := itertools.Backoff(tc.base, tc.maxDelay, tc.factor, 0)
+
+			got := slices.Collect(itertools.SliceUntil(seq, len(tc.expected), 1))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestBackoff_jitter(t *testing.T) {
+	seq := itertools.Backoff(time.Second, 4*time.Second, 2, 0.5)
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second}
+
+	got := slices.Collect(itertools.SliceUntil(seq, len(expected), 1))
+
+	require.Len(t, got, len(expected))
+	for i, d := range got {
+		require.GreaterOrEqual(t, d, expected[i]/2)
+		require.LessOrEqual(t, d, expected[i]*3/2)
+	}
+}
+
+func TestBackoff_panics(t *testing.T) {
+	for _, tc := range []struct {
+		base     time.Duration
+		maxDelay time.Duration
+		factor   float64
+		jitter   float64
+		expected string
+	}{
+		{0, time.Second, 2, 0, "base for Backoff must be positive"},
+		{time.Second, time.Millisecond, 2, 0, "maxDelay for Backoff must be at least base"},
+		{time.Second, time.Hour, 0.5, 0, "factor for Backoff must be at least 1"},
+		{time.Second, time.Hour, 2, -0.1, "jitter for Backoff must be between 0 and 1"},
+		{time.Second, time.Hour, 2, 1.1, "jitter for Backoff must be between 0 and 1"},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			require.PanicsWithValue(
+				t,
+				tc.expected,
+				func() { itertools.Backoff(tc.base, tc.maxDelay, tc.factor, tc.jitter) },
+			)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+// Package tuple provides small generic product types for grouping values
+// that are yielded together, e.g. for converting a [iter.Seq2] into a
+// [iter.Seq], or for combining more than two sequences.
+package tuple
+
+import "iter"
+
+// Pair holds two values.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// NewPair returns a [Pair] holding first and second.
+func NewPair[A any, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{first, second}
+}
+
+// Unpack returns the values held by p.
+func (p Pair[A, B]) Unpack() (A, B) { //nolint:ireturn
+	return p.First, p.Second
+}
+
+// Triple holds three values.
+type Triple[A any, B any, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// NewTriple returns a [Triple] holding first, second and third.
+func NewTriple[A any, B any, C any](first A, second B, third C) Triple[A, B, C] {
+	return Triple[A, B, C]{first, second, third}
+}
+
+// Unpack returns the values held by t.
+func (t Triple[A, B, C]) Unpack() (A, B, C) { //nolint:ireturn
+	return t.First, t.Second, t.Third
+}
+
+// Zip returns a [iter.Seq] that yields a [Pair] of values from seq1 and seq2.
+// Stops when either sequence is exhausted.
+func Zip[A any, B any](seq1 iter.Seq[A], seq2 iter.Seq[B]) iter.Seq[Pair[A, B]] {
+	return func(yield func(Pair[A, B]) bool) {
+		next, stop := iter.Pull(seq2)
+		defer stop()
+
+		for a := range seq1 {
+			b, ok := next()
+			if !ok || !yield(Pair[A, B]{a, b}) {
+				return
+			}
+		}
+	}
+}
+
+// Zip3 is like [Zip] but yields a [Triple] of values from three sequences.
+func Zip3[A any, B any, C any](
+	seq1 iter.Seq[A],
+	seq2 iter.Seq[B],
+	seq3 iter.Seq[C],
+) iter.Seq[Triple[A, B, C]] {
+	return func(yield func(Triple[A, B, C]) bool) {
+		next2, stop2 := iter.Pull(seq2)
+		defer stop2()
+		next3, stop3 := iter.Pull(seq3)
+		defer stop3()
+
+		for a := range seq1 {
+			b, ok := next2()
+			if !ok {
+				return
+			}
+			c, ok := next3()
+			if !ok || !yield(Triple[A, B, C]{a, b, c}) {
+				return
+			}
+		}
+	}
+}
+
+// CollectPairs collects the values of seq into a new slice of [Pair].
+func CollectPairs[A any, B any](seq iter.Seq2[A, B]) []Pair[A, B] {
+	var res []Pair[A, B]
+	for a, b := range seq {
+		res = append(res, Pair[A, B]{a, b})
+	}
+	return res
+}
+
+// Unzip collects the values held by each [Pair] of seq into two new slices.
+func Unzip[A any, B any](seq iter.Seq[Pair[A, B]]) ([]A, []B) {
+	var firsts []A
+	var seconds []B
+	for p := range seq {
+		firsts = append(firsts, p.First)
+		seconds = append(seconds, p.Second)
+	}
+	return firsts, seconds
+}
@@ -0,0 +1,76 @@
+package tuple_test
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/matthewhughes934/go-itertools/itertools/tuple"
+)
+
+func ExamplePair_Unpack() {
+	p := tuple.NewPair("one", 1)
+
+	s, n := p.Unpack()
+	fmt.Println(s, n)
+
+	// output:
+	// one 1
+}
+
+func ExampleTriple_Unpack() {
+	t := tuple.NewTriple("one", 1, true)
+
+	s, n, b := t.Unpack()
+	fmt.Println(s, n, b)
+
+	// output:
+	// one 1 true
+}
+
+func ExampleZip() {
+	names := slices.Values([]string{"one", "two", "three"})
+	values := slices.Values([]int{1, 2, 3})
+
+	for p := range tuple.Zip(names, values) {
+		fmt.Println(p.First, p.Second)
+	}
+
+	// output:
+	// one 1
+	// two 2
+	// three 3
+}
+
+func ExampleZip3() {
+	names := slices.Values([]string{"one", "two"})
+	values := slices.Values([]int{1, 2})
+	odd := slices.Values([]bool{true, false})
+
+	for t := range tuple.Zip3(names, values, odd) {
+		fmt.Println(t.Unpack())
+	}
+
+	// output:
+	// one 1 true
+	// two 2 false
+}
+
+func ExampleCollectPairs() {
+	pairs := tuple.CollectPairs(maps.All(map[string]int{"one": 1}))
+
+	fmt.Println(pairs)
+
+	// output:
+	// [{one 1}]
+}
+
+func ExampleUnzip() {
+	pairs := slices.Values([]tuple.Pair[string, int]{{"one", 1}, {"two", 2}})
+
+	names, values := tuple.Unzip(pairs)
+	fmt.Println(names, values)
+
+	// output:
+	// [one two] [1 2]
+}
@@ -0,0 +1,102 @@
+package tuple_test
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matthewhughes934/go-itertools/itertools/tuple"
+)
+
+// take returns a sequence that yields at most n values of seq, so we can
+// check early exit without importing itertools
+func take[V any](seq iter.Seq[V], n int) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		if n <= 0 {
+			return
+		}
+		count := 0
+		for v := range seq {
+			count++
+			if !yield(v) || count == n {
+				return
+			}
+		}
+	}
+}
+
+func TestZip(t *testing.T) {
+	for _, tc := range []struct {
+		first    []int
+		second   []string
+		expected []tuple.Pair[int, string]
+	}{
+		{nil, nil, nil},
+		{[]int{1, 2}, []string{"a"}, []tuple.Pair[int, string]{{1, "a"}}},
+		{[]int{1}, []string{"a", "b"}, []tuple.Pair[int, string]{{1, "a"}}},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := slices.Collect(tuple.Zip(slices.Values(tc.first), slices.Values(tc.second)))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestZip_earlyStop(t *testing.T) {
+	seq := tuple.Zip(slices.Values([]int{1, 2, 3}), slices.Values([]string{"a", "b", "c"}))
+	expected := []tuple.Pair[int, string]{{1, "a"}, {2, "b"}}
+
+	got := slices.Collect(take(seq, 2))
+
+	require.Equal(t, expected, got)
+}
+
+func TestZip3(t *testing.T) {
+	for _, tc := range []struct {
+		first    []int
+		second   []string
+		third    []bool
+		expected []tuple.Triple[int, string, bool]
+	}{
+		{nil, nil, nil, nil},
+		{
+			[]int{1, 2},
+			[]string{"a"},
+			[]bool{true, false},
+			[]tuple.Triple[int, string, bool]{{1, "a", true}},
+		},
+		{
+			[]int{1, 2},
+			[]string{"a", "b"},
+			[]bool{true},
+			[]tuple.Triple[int, string, bool]{{1, "a", true}},
+		},
+	} {
+		t.Run(fmt.Sprintf("%+v", tc), func(t *testing.T) {
+			got := slices.Collect(tuple.Zip3(
+				slices.Values(tc.first),
+				slices.Values(tc.second),
+				slices.Values(tc.third),
+			))
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestZip3_earlyStop(t *testing.T) {
+	seq := tuple.Zip3(
+		slices.Values([]int{1, 2, 3}),
+		slices.Values([]string{"a", "b", "c"}),
+		slices.Values([]bool{true, false, true}),
+	)
+	expected := []tuple.Triple[int, string, bool]{{1, "a", true}}
+
+	got := slices.Collect(take(seq, 1))
+
+	require.Equal(t, expected, got)
+}